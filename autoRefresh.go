@@ -0,0 +1,185 @@
+// Package gtk4go provides auto-refresh functionality for GTK4.
+// File: gtk4go/autoRefresh.go
+package gtk4go
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/justyntemme/gtk4go/core/uithread"
+)
+
+// AutoRefresher periodically runs a refresh function on the UI thread.
+// It re-arms itself after every tick and skips a tick if the previous
+// refresh has not finished yet. A panic in the refresh function is reported
+// (see uithread.SetPanicHandler) and stops the refresher until it is
+// re-armed by Start, SetInterval or SetEnabled.
+type AutoRefresher struct {
+	mu         sync.Mutex
+	interval   time.Duration
+	enabled    bool
+	started    bool
+	source     SourceHandle
+	generation uint64 // Identifies the current source, so stale ticks are ignored
+	refresh    func(done func())
+	inFlight   atomic.Bool
+}
+
+// NewAutoRefresher creates an auto-refresher that calls refresh on the UI thread
+// every interval. The refresh is considered finished as soon as it returns,
+// so a refresh that hands its work to a background task should use
+// NewAsyncAutoRefresher instead to avoid overlapping refreshes.
+// The refresher is enabled but not started; call Start to begin refreshing.
+func NewAutoRefresher(interval time.Duration, refresh func()) *AutoRefresher {
+	return NewAsyncAutoRefresher(interval, func(done func()) {
+		defer done()
+		refresh()
+	})
+}
+
+// NewAsyncAutoRefresher creates an auto-refresher for refresh functions that
+// finish asynchronously, for example by queueing a background task.
+// The refresh function must call done once the refresh has finished;
+// ticks that occur before that are skipped.
+func NewAsyncAutoRefresher(interval time.Duration, refresh func(done func())) *AutoRefresher {
+	return &AutoRefresher{
+		interval: interval,
+		enabled:  true,
+		refresh:  refresh,
+	}
+}
+
+// Start begins periodic refreshing
+func (r *AutoRefresher) Start() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.started = true
+	r.rearm()
+}
+
+// Stop stops periodic refreshing. A refresh that is already running is not interrupted.
+func (r *AutoRefresher) Stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.started = false
+	r.disarm()
+}
+
+// SetInterval changes the refresh interval. If the refresher is active,
+// the next refresh is rescheduled using the new interval.
+func (r *AutoRefresher) SetInterval(interval time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.interval = interval
+	r.rearm()
+}
+
+// GetInterval returns the refresh interval
+func (r *AutoRefresher) GetInterval() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.interval
+}
+
+// SetEnabled enables or disables refreshing without forgetting whether
+// the refresher was started
+func (r *AutoRefresher) SetEnabled(enabled bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.enabled = enabled
+	r.rearm()
+}
+
+// IsEnabled returns whether the refresher is enabled
+func (r *AutoRefresher) IsEnabled() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.enabled
+}
+
+// IsActive returns whether the refresher is started, enabled and scheduled
+func (r *AutoRefresher) IsActive() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.source != 0
+}
+
+// IsRefreshing returns whether a refresh is currently in progress
+func (r *AutoRefresher) IsRefreshing() bool {
+	return r.inFlight.Load()
+}
+
+// rearm cancels any scheduled tick and schedules a new one if the
+// refresher is started, enabled and has a positive interval.
+// Must be called with r.mu held.
+func (r *AutoRefresher) rearm() {
+	r.disarm()
+
+	if !r.started || !r.enabled || r.interval <= 0 {
+		return
+	}
+
+	r.generation++
+	generation := r.generation
+	r.source = TimeoutAdd(int(r.interval.Milliseconds()), func() bool {
+		return r.tick(generation)
+	})
+}
+
+// disarm cancels the scheduled tick. Must be called with r.mu held.
+func (r *AutoRefresher) disarm() {
+	if r.source != 0 {
+		SourceRemove(r.source)
+		r.source = 0
+	}
+}
+
+// tick runs on the UI thread each time the interval elapses. It returns
+// false to end the timeout source of the given generation.
+func (r *AutoRefresher) tick(generation uint64) bool {
+	// A tick dispatched just before its source was removed or replaced
+	// must not refresh
+	r.mu.Lock()
+	current := r.source != 0 && r.generation == generation
+	r.mu.Unlock()
+	if !current {
+		return false
+	}
+
+	// Skip this tick if the previous refresh is still running
+	if !r.inFlight.CompareAndSwap(false, true) {
+		return true
+	}
+
+	var once sync.Once
+	done := func() {
+		once.Do(func() {
+			r.inFlight.Store(false)
+		})
+	}
+
+	finished := false
+	uithread.Protect(func() {
+		r.refresh(done)
+		finished = true
+	})
+	if !finished {
+		// The refresh panicked, so it may never call done. Stop refreshing,
+		// as the next refresh would likely panic too.
+		done()
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.generation == generation {
+			r.source = 0
+		}
+		return false
+	}
+
+	// Keep the timeout source alive; Stop and SetEnabled remove it
+	return true
+}
@@ -0,0 +1,126 @@
+package gtk4go
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/justyntemme/gtk4go/core/uithread"
+)
+
+func TestAutoRefresherSkipsTicksWhileRefreshing(t *testing.T) {
+	var started atomic.Int32
+	finish := make(chan func(), 2)
+
+	// A slow refresh that only finishes when the test calls done, as one
+	// that hands its work to a background task would
+	refresher := NewAsyncAutoRefresher(5*time.Millisecond, func(done func()) {
+		started.Add(1)
+		finish <- done
+	})
+	refresher.Start()
+	defer refresher.Stop()
+
+	var done func()
+	select {
+	case done = <-finish:
+	case <-time.After(5 * time.Second):
+		t.Fatal("first refresh didn't start")
+	}
+
+	// Many intervals elapse while the refresh is in flight
+	time.Sleep(100 * time.Millisecond)
+	if n := started.Load(); n != 1 {
+		t.Fatalf("%d refreshes started while the first was in flight, want 1", n)
+	}
+	if !refresher.IsRefreshing() {
+		t.Error("IsRefreshing is false while the refresh is in flight")
+	}
+
+	// Finishing on the UI thread, like a background task's completion
+	// callback, lets the next tick refresh again
+	RunOnUIThread(done)
+	select {
+	case <-finish:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no refresh started after the first one finished")
+	}
+}
+
+func TestAutoRefresherStop(t *testing.T) {
+	var started atomic.Int32
+	refresher := NewAutoRefresher(5*time.Millisecond, func() {
+		started.Add(1)
+	})
+	refresher.Start()
+	if !refresher.IsActive() {
+		t.Fatal("refresher isn't active after Start")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for started.Load() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("refresh didn't run")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// A tick may already be queued on the UI thread when Stop is called;
+	// once it has run, no further refreshes start
+	refresher.Stop()
+	if refresher.IsActive() {
+		t.Error("refresher is active after Stop")
+	}
+	RunOnUIThreadSync(func() {})
+	n := started.Load()
+	time.Sleep(50 * time.Millisecond)
+	if got := started.Load(); got != n {
+		t.Errorf("%d refreshes ran after Stop", got-n)
+	}
+}
+
+func TestAutoRefresherStopsAfterPanic(t *testing.T) {
+	panics := make(chan interface{}, 1)
+	uithread.SetPanicHandler(func(value interface{}, _ []byte) {
+		select {
+		case panics <- value:
+		default:
+		}
+	})
+	defer uithread.SetPanicHandler(nil)
+
+	var started atomic.Int32
+	refresher := NewAsyncAutoRefresher(5*time.Millisecond, func(done func()) {
+		started.Add(1)
+		panic("refresh failed")
+	})
+	refresher.Start()
+	defer refresher.Stop()
+
+	select {
+	case value := <-panics:
+		if value != "refresh failed" {
+			t.Errorf("reported panic %v, want the refresh's panic", value)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("panic in the refresh wasn't reported")
+	}
+
+	RunOnUIThreadSync(func() {})
+	if refresher.IsActive() {
+		t.Error("refresher is still active after its refresh panicked")
+	}
+	if refresher.IsRefreshing() {
+		t.Error("IsRefreshing is true after the refresh panicked")
+	}
+	time.Sleep(50 * time.Millisecond)
+	if n := started.Load(); n != 1 {
+		t.Errorf("%d refreshes started, want 1", n)
+	}
+
+	// Starting again re-arms the refresher
+	refresher.Start()
+	if !refresher.IsActive() {
+		t.Error("refresher isn't active after Start")
+	}
+}
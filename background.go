@@ -4,8 +4,10 @@ package gtk4go
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"runtime"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -21,7 +23,47 @@ type BackgroundWorker struct {
 	activeWorkers atomic.Int32
 	workerCount   atomic.Int32
 	nextTaskID    atomic.Uint64
-	mu            sync.RWMutex // Used only for fields not amenable to atomic ops
+	nextWorkerID  atomic.Int32
+	mu            sync.RWMutex         // Used only for fields not amenable to atomic ops
+	tasks         map[string]*WorkItem // Queued and running tasks by ID, guarded by mu
+	resized       chan struct{}        // Closed and replaced when the pool size changes, guarded by mu
+	queueMu       sync.RWMutex         // Held for reading while queuing, for writing while stopping
+	fullPolicy    QueueFullPolicy
+	stopOnce      sync.Once
+	stopped       chan struct{} // Closed once Stop has finished
+}
+
+// QueueFullPolicy decides what happens to a task queued while the work queue is full
+type QueueFullPolicy int
+
+const (
+	// QueueFullReject fails the task immediately with ErrQueueFull
+	QueueFullReject QueueFullPolicy = iota
+	// QueueFullBlock blocks the caller until there is room in the queue.
+	// Don't use it for workers that are fed from the UI thread.
+	QueueFullBlock
+)
+
+// Errors passed to the completion callback of tasks that didn't run
+var (
+	// ErrQueueFull is reported for tasks rejected because the work queue is full
+	ErrQueueFull = errors.New("work queue is full")
+	// ErrWorkerStopped is reported for tasks queued on a stopped worker,
+	// and for queued tasks that were discarded by Stop
+	ErrWorkerStopped = errors.New("worker is not running")
+)
+
+// WorkerConfig configures a background worker
+type WorkerConfig struct {
+	// MaxWorkers is the number of tasks that run concurrently.
+	// Defaults to the number of CPUs.
+	MaxWorkers int
+	// QueueSize is the number of tasks that can wait for a free worker.
+	// Defaults to 100.
+	QueueSize int
+	// QueueFullPolicy decides what happens when the queue is full.
+	// Defaults to QueueFullReject.
+	QueueFullPolicy QueueFullPolicy
 }
 
 // WorkStatus represents the status of a background task
@@ -40,6 +82,9 @@ const (
 	StatusCancelled
 )
 
+// TaskFunc is a background task that reports its progress and observes ctx for cancellation
+type TaskFunc func(ctx context.Context, progress func(percent int, message string)) (interface{}, error)
+
 // WorkItem represents a unit of work to be processed
 type WorkItem struct {
 	ID          string
@@ -61,41 +106,113 @@ type WorkItem struct {
 
 // NewBackgroundWorker creates a new background worker
 func NewBackgroundWorker(numWorkers int) *BackgroundWorker {
-	if numWorkers <= 0 {
-		numWorkers = runtime.NumCPU()
+	return NewWorker(WorkerConfig{MaxWorkers: numWorkers})
+}
+
+// NewWorker creates a new background worker with the given configuration
+func NewWorker(config WorkerConfig) *BackgroundWorker {
+	if config.MaxWorkers <= 0 {
+		config.MaxWorkers = runtime.NumCPU()
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = 100
 	}
 
 	worker := &BackgroundWorker{
-		workQueue: make(chan *WorkItem, 100),
-		stopChan:  make(chan struct{}),
+		workQueue:  make(chan *WorkItem, config.QueueSize),
+		stopChan:   make(chan struct{}),
+		tasks:      make(map[string]*WorkItem),
+		resized:    make(chan struct{}),
+		fullPolicy: config.QueueFullPolicy,
+		stopped:    make(chan struct{}),
 	}
-	
+
 	// Set initial state
 	worker.isRunning.Store(true)
-	worker.workerCount.Store(int32(numWorkers))
+	worker.workerCount.Store(int32(config.MaxWorkers))
 
 	// Start worker goroutines
-	worker.wg.Add(numWorkers)
-	for i := 0; i < numWorkers; i++ {
-		workerID := i
-		go worker.processWork(workerID)
+	for i := 0; i < config.MaxWorkers; i++ {
+		worker.startWorker()
 	}
 
 	return worker
 }
 
+// startWorker starts a worker goroutine
+func (w *BackgroundWorker) startWorker() {
+	w.wg.Add(1)
+	w.activeWorkers.Add(1)
+	go w.processWork(int(w.nextWorkerID.Add(1)))
+}
+
+// SetMaxWorkers changes the number of tasks that run concurrently.
+// Growing the pool takes effect immediately; when shrinking, busy workers
+// exit once they have finished their current task.
+func (w *BackgroundWorker) SetMaxWorkers(maxWorkers int) {
+	if maxWorkers <= 0 {
+		maxWorkers = runtime.NumCPU()
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.isRunning.Load() {
+		return
+	}
+
+	w.workerCount.Store(int32(maxWorkers))
+	for i := int(w.activeWorkers.Load()); i < maxWorkers; i++ {
+		w.startWorker()
+	}
+
+	// Wake idle workers so that surplus ones exit
+	close(w.resized)
+	w.resized = make(chan struct{})
+}
+
+// GetMaxWorkers returns the number of tasks that run concurrently
+func (w *BackgroundWorker) GetMaxWorkers() int {
+	return int(w.workerCount.Load())
+}
+
+// retire reports whether the calling worker should exit because the pool
+// has shrunk. If so, the worker is no longer counted as active.
+func (w *BackgroundWorker) retire() bool {
+	for {
+		active := w.activeWorkers.Load()
+		if active <= w.workerCount.Load() {
+			return false
+		}
+		if w.activeWorkers.CompareAndSwap(active, active-1) {
+			return true
+		}
+	}
+}
+
+// resizeSignal returns a channel that is closed when the pool size changes
+func (w *BackgroundWorker) resizeSignal() <-chan struct{} {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.resized
+}
+
 // processWork runs in a goroutine to process work items
 func (w *BackgroundWorker) processWork(workerID int) {
 	defer w.wg.Done()
-	
-	// Track active worker count
-	w.activeWorkers.Add(1)
-	defer w.activeWorkers.Add(-1)
 
 	for {
+		if w.retire() {
+			return
+		}
+
 		select {
 		case <-w.stopChan:
+			w.activeWorkers.Add(-1)
 			return
+		case <-w.resizeSignal():
+			// Check whether this worker is still needed
+			continue
 		case item := <-w.workQueue:
 			if item == nil {
 				continue
@@ -161,6 +278,7 @@ func (w *BackgroundWorker) processWork(workerID int) {
 			}
 			
 			item.status.Store(int32(finalStatus))
+			w.untrackTask(item)
 
 			// Execute completion callback on UI thread
 			if item.OnComplete != nil {
@@ -183,23 +301,51 @@ func (w *BackgroundWorker) processWork(workerID int) {
 	}
 }
 
-// QueueTask queues a task for background execution
+// QueueTask queues a task for background execution. Queuing a task with the
+// ID of a task that is still queued or running replaces it: the previous
+// task is cancelled. An empty ID generates a unique one.
+// If the queue is full, the worker's QueueFullPolicy decides whether the
+// task fails with ErrQueueFull or QueueTask blocks until there is room.
+// A task that can't be queued completes with the error; use TryQueueTask to
+// get it when queuing instead.
 func (w *BackgroundWorker) QueueTask(
 	id string,
 	task func(ctx context.Context, progress func(percent int, message string)) (interface{}, error),
 	onComplete func(result interface{}, err error),
 	onProgress func(percent int, message string),
 ) context.CancelFunc {
-	// Check if we're running using atomic operations
-	if !w.isRunning.Load() {
+	cancelFunc, err := w.TryQueueTask(id, task, onComplete, onProgress)
+	if err != nil {
 		if onComplete != nil {
 			RunOnUIThread(func() {
-				onComplete(nil, fmt.Errorf("worker is not running"))
+				onComplete(nil, err)
 			})
 		}
 		return func() {}
 	}
-	
+	return cancelFunc
+}
+
+// TryQueueTask is like QueueTask, but returns ErrQueueFull or
+// ErrWorkerStopped if the task can't be queued, in which case onComplete
+// is never called. This lets callers such as a refresh button tell that
+// their work was dropped.
+func (w *BackgroundWorker) TryQueueTask(
+	id string,
+	task func(ctx context.Context, progress func(percent int, message string)) (interface{}, error),
+	onComplete func(result interface{}, err error),
+	onProgress func(percent int, message string),
+) (context.CancelFunc, error) {
+	// Stop waits for tasks being queued, so a task is either queued before
+	// the worker stops or rejected
+	w.queueMu.RLock()
+	defer w.queueMu.RUnlock()
+
+	// Check if we're running using atomic operations
+	if !w.isRunning.Load() {
+		return nil, ErrWorkerStopped
+	}
+
 	// Generate ID if none provided
 	if id == "" {
 		id = fmt.Sprintf("task-%d", w.nextTaskID.Add(1))
@@ -222,21 +368,87 @@ func (w *BackgroundWorker) QueueTask(
 	item.status.Store(int32(StatusPending))
 	item.lastUpdate.Store(time.Now())
 
-	// Try to queue the work with a timeout to prevent deadlocks
+	// A task with the same ID replaces the previous one, which is cancelled
+	w.trackTask(item)
+
+	if w.fullPolicy == QueueFullBlock {
+		w.workQueue <- item
+		return cancelFunc, nil
+	}
+
 	select {
 	case w.workQueue <- item:
 		// Successfully queued
-	case <-time.After(100 * time.Millisecond):
-		// Queue is full or blocked
-		if onComplete != nil {
-			RunOnUIThread(func() {
-				onComplete(nil, fmt.Errorf("work queue is full"))
-			})
-		}
+	default:
+		// Queue is full
+		w.untrackTask(item)
 		cancelFunc()
+		return nil, ErrQueueFull
 	}
 
-	return cancelFunc
+	return cancelFunc, nil
+}
+
+// trackTask registers a queued task by ID, cancelling any task it replaces
+func (w *BackgroundWorker) trackTask(item *WorkItem) {
+	w.mu.Lock()
+	previous := w.tasks[item.ID]
+	w.tasks[item.ID] = item
+	w.mu.Unlock()
+
+	if previous != nil {
+		previous.cancelFunc()
+	}
+}
+
+// untrackTask removes a finished task from the registry, unless it has
+// already been replaced by a newer task with the same ID
+func (w *BackgroundWorker) untrackTask(item *WorkItem) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.tasks[item.ID] == item {
+		delete(w.tasks, item.ID)
+	}
+}
+
+// Cancel cancels the queued or running task with the given ID. The task's
+// completion callback still runs. Returns false if no such task exists.
+func (w *BackgroundWorker) Cancel(id string) bool {
+	w.mu.Lock()
+	item, ok := w.tasks[id]
+	if ok {
+		delete(w.tasks, id)
+	}
+	w.mu.Unlock()
+
+	if ok {
+		item.cancelFunc()
+	}
+	return ok
+}
+
+// IsTaskRunning returns whether a task with the given ID is queued or running.
+// Cancelled tasks are no longer reported, even before they have returned.
+func (w *BackgroundWorker) IsTaskRunning(id string) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	_, ok := w.tasks[id]
+	return ok
+}
+
+// RunningTasks returns the IDs of all queued and running tasks, sorted by ID
+func (w *BackgroundWorker) RunningTasks() []string {
+	w.mu.RLock()
+	ids := make([]string, 0, len(w.tasks))
+	for id := range w.tasks {
+		ids = append(ids, id)
+	}
+	w.mu.RUnlock()
+
+	sort.Strings(ids)
+	return ids
 }
 
 // SetProgressUpdateInterval sets the minimum time between progress updates
@@ -257,41 +469,64 @@ func (w *BackgroundWorker) IsRunning() bool {
 	return w.isRunning.Load()
 }
 
-// Stop stops the worker and waits for all tasks to complete
+// Stop stops the worker. Running tasks are cancelled and Stop waits for
+// them to return; their completion callbacks still run. Queued tasks are
+// discarded and complete with ErrWorkerStopped.
+//
+// Stop can be called any number of times, from any goroutine except a
+// task of the same worker; later calls wait for the first one to finish.
+// Note that deferred calls don't run when the program exits via os.Exit,
+// so a worker that must be stopped should be stopped before exiting.
 func (w *BackgroundWorker) Stop() {
-	// Use atomic operation to check and update running state
-	if !w.isRunning.CompareAndSwap(true, false) {
-		// Already stopped
-		return
-	}
-	
-	close(w.stopChan)
-
-	// Wait for all workers to finish
-	w.wg.Wait()
+	w.stopOnce.Do(w.stop)
+	<-w.stopped
 }
 
-// Shutdown stops the worker and cancels any pending or running tasks
-func (w *BackgroundWorker) Shutdown(timeout time.Duration) bool {
-	// Stop accepting new tasks
-	if !w.isRunning.CompareAndSwap(true, false) {
-		// Already stopped
-		return true
+// stop performs the shutdown for Stop
+func (w *BackgroundWorker) stop() {
+	defer close(w.stopped)
+
+	// Stop accepting new tasks, waiting for tasks being queued
+	w.queueMu.Lock()
+	w.isRunning.Store(false)
+	w.queueMu.Unlock()
+
+	// Cancel all queued and running tasks
+	w.mu.Lock()
+	for id, item := range w.tasks {
+		item.cancelFunc()
+		delete(w.tasks, id)
 	}
+	w.mu.Unlock()
 
-	// Close the stop channel to signal workers to exit
+	// Close the stop channel to signal workers to exit, and wait for them
 	close(w.stopChan)
+	w.wg.Wait()
 
-	// Use a channel to signal completion
-	done := make(chan struct{})
-	go func() {
-		w.wg.Wait()
-		close(done)
-	}()
+	// Discard the tasks that never started
+	for {
+		select {
+		case item := <-w.workQueue:
+			item.status.Store(int32(StatusCancelled))
+			if item.OnComplete != nil {
+				RunOnUIThread(func() {
+					item.OnComplete(nil, ErrWorkerStopped)
+				})
+			}
+		default:
+			return
+		}
+	}
+}
+
+// Shutdown stops the worker like Stop, but waits at most timeout for
+// running tasks to return. Returns false if the timeout expired; the
+// worker then finishes stopping in the background.
+func (w *BackgroundWorker) Shutdown(timeout time.Duration) bool {
+	go w.stopOnce.Do(w.stop)
 
-	// Wait for workers to finish with timeout
 	select {
-	case <-done:
+	case <-w.stopped:
 		return true
 	case <-time.After(timeout):
 		return false
@@ -311,12 +546,29 @@ func QueueBackgroundTask(
 	return DefaultWorker.QueueTask(id, task, onComplete, onProgress)
 }
 
-// RunInBackground runs a simple task without progress updates
+// RunInBackground runs a simple task without progress updates. If the
+// default worker can't take the task, onComplete receives ErrQueueFull or
+// ErrWorkerStopped; see TryRunInBackground.
 func RunInBackground(
 	task func() (interface{}, error),
 	onComplete func(result interface{}, err error),
 ) context.CancelFunc {
-	wrappedTask := func(ctx context.Context, _ func(int, string)) (interface{}, error) {
+	return QueueBackgroundTask("", simpleTask(task), onComplete, nil)
+}
+
+// TryRunInBackground is like RunInBackground, but returns ErrQueueFull or
+// ErrWorkerStopped if the default worker can't take the task, in which case
+// onComplete is never called
+func TryRunInBackground(
+	task func() (interface{}, error),
+	onComplete func(result interface{}, err error),
+) (context.CancelFunc, error) {
+	return DefaultWorker.TryQueueTask("", simpleTask(task), onComplete, nil)
+}
+
+// simpleTask wraps a task without progress updates for QueueTask
+func simpleTask(task func() (interface{}, error)) TaskFunc {
+	return func(ctx context.Context, _ func(int, string)) (interface{}, error) {
 		// Check for cancellation
 		select {
 		case <-ctx.Done():
@@ -327,10 +579,143 @@ func RunInBackground(
 
 		return task()
 	}
+}
+
+// progressCoalescer forwards progress updates to the UI thread, keeping at
+// most one update queued. Updates reported while one is queued replace it,
+// so a task reporting progress rapidly can't flood the UI thread.
+type progressCoalescer struct {
+	mu         sync.Mutex
+	onProgress func(percent int, message string)
+	percent    int
+	message    string
+	queued     bool
+	finished   bool
+}
+
+// report records the latest progress and queues delivery if needed.
+// Reports after finish are dropped.
+func (c *progressCoalescer) report(percent int, message string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.finished {
+		return
+	}
+
+	c.percent, c.message = percent, message
+	if c.queued {
+		return
+	}
+	c.queued = true
+
+	RunOnUIThread(c.deliver)
+}
+
+// deliver passes the latest progress to onProgress on the UI thread
+func (c *progressCoalescer) deliver() {
+	c.mu.Lock()
+	percent, message := c.percent, c.message
+	c.queued = false
+	c.mu.Unlock()
+
+	c.onProgress(percent, message)
+}
+
+// finish drops all further reports. An update that is already queued is
+// still delivered, before the task's completion callback.
+func (c *progressCoalescer) finish() {
+	c.mu.Lock()
+	c.finished = true
+	c.mu.Unlock()
+}
+
+// RunInBackgroundWithProgress runs a task that reports its progress.
+// onProgress and onComplete run on the UI thread. Rapid progress reports
+// are coalesced so that only the latest one is delivered, and reports made
+// after the task has returned are dropped.
+func RunInBackgroundWithProgress(
+	task func(progress func(percent int, message string)) (interface{}, error),
+	onComplete func(result interface{}, err error),
+	onProgress func(percent int, message string),
+) context.CancelFunc {
+	progress := func(int, string) {}
+	var coalescer *progressCoalescer
+	if onProgress != nil {
+		coalescer = &progressCoalescer{onProgress: onProgress}
+		progress = coalescer.report
+	}
+
+	wrappedTask := func(ctx context.Context, _ func(int, string)) (interface{}, error) {
+		if coalescer != nil {
+			defer coalescer.finish()
+		}
+
+		// Check for cancellation
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// Continue with task
+		}
+
+		return task(progress)
+	}
 
 	return QueueBackgroundTask("", wrappedTask, onComplete, nil)
 }
 
+// QueueBackgroundTaskT is a typed variant of QueueBackgroundTask that passes
+// the task's result to onComplete without a type assertion. If the task is
+// cancelled, onComplete receives the zero value of T and context.Canceled.
+func QueueBackgroundTaskT[T any](
+	id string,
+	task func(ctx context.Context, progress func(percent int, message string)) (T, error),
+	onComplete func(result T, err error),
+	onProgress func(percent int, message string),
+) context.CancelFunc {
+	wrappedTask := func(ctx context.Context, progress func(int, string)) (interface{}, error) {
+		var zero T
+
+		// Don't start a task that was cancelled while queued
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+
+		result, err := task(ctx, progress)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return zero, ctxErr
+		}
+		return result, err
+	}
+
+	var wrappedComplete func(interface{}, error)
+	if onComplete != nil {
+		wrappedComplete = func(result interface{}, err error) {
+			// The result is nil if the task never ran, e.g. when the queue is full
+			typed, _ := result.(T)
+			onComplete(typed, err)
+		}
+	}
+
+	return QueueBackgroundTask(id, wrappedTask, wrappedComplete, onProgress)
+}
+
+// RunInBackgroundT is a typed variant of RunInBackground that passes the
+// task's result to onComplete without a type assertion. onComplete runs on
+// the UI thread. If the task is cancelled, onComplete receives the zero value
+// of T and context.Canceled.
+func RunInBackgroundT[T any](
+	task func() (T, error),
+	onComplete func(result T, err error),
+) context.CancelFunc {
+	wrappedTask := func(ctx context.Context, _ func(int, string)) (T, error) {
+		return task()
+	}
+
+	return QueueBackgroundTaskT("", wrappedTask, onComplete, nil)
+}
+
 // ShutdownDefaultWorker shuts down the default worker with a timeout
 func ShutdownDefaultWorker(timeout time.Duration) bool {
 	return DefaultWorker.Shutdown(timeout)
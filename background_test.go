@@ -0,0 +1,68 @@
+package gtk4go
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTryQueueTaskReportsRejection(t *testing.T) {
+	worker := NewWorker(WorkerConfig{MaxWorkers: 1, QueueSize: 1})
+	defer worker.Stop()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	blocking := func(ctx context.Context, _ func(int, string)) (interface{}, error) {
+		close(started)
+		<-release
+		return nil, nil
+	}
+	idle := func(ctx context.Context, _ func(int, string)) (interface{}, error) {
+		return nil, nil
+	}
+	rejected := func(result interface{}, err error) {
+		t.Errorf("completion callback of a rejected task called with %v", err)
+	}
+
+	// Occupy the only worker, then fill the queue
+	if _, err := worker.TryQueueTask("", blocking, nil, nil); err != nil {
+		t.Fatalf("TryQueueTask: %v", err)
+	}
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("task didn't start")
+	}
+	if _, err := worker.TryQueueTask("", idle, nil, nil); err != nil {
+		t.Fatalf("TryQueueTask with room in the queue: %v", err)
+	}
+
+	if _, err := worker.TryQueueTask("", idle, rejected, nil); !errors.Is(err, ErrQueueFull) {
+		t.Errorf("TryQueueTask on a full queue returned %v, want ErrQueueFull", err)
+	}
+
+	// QueueTask reports the rejection to the completion callback instead
+	result := make(chan error, 1)
+	worker.QueueTask("", idle, func(_ interface{}, err error) {
+		result <- err
+	}, nil)
+	select {
+	case err := <-result:
+		if !errors.Is(err, ErrQueueFull) {
+			t.Errorf("QueueTask on a full queue completed with %v, want ErrQueueFull", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("QueueTask on a full queue didn't complete")
+	}
+
+	close(release)
+	worker.Stop()
+
+	if _, err := worker.TryQueueTask("", idle, rejected, nil); !errors.Is(err, ErrWorkerStopped) {
+		t.Errorf("TryQueueTask on a stopped worker returned %v, want ErrWorkerStopped", err)
+	}
+
+	// Let the callbacks of rejected tasks run, if they were wrongly queued
+	RunOnUIThreadSync(func() {})
+}
@@ -1,3 +0,0 @@
-module github.com/justyntemme/gtk4go/core/uithread
-
-go 1.24.1
@@ -27,7 +27,8 @@ func initPlatformIdleHandler() {
     RegisterIdleHandler = func(fn func()) {
         // Store function in Go-side map to prevent GC
         idleKey := nextIdleKey.Add(1)
-        idleFunctions.Store(idleKey, fn)
+        run, _ := Track(fn)
+        idleFunctions.Store(idleKey, run)
         
         // Use Cocoa's dispatch_async with main queue
         C.dispatchToMainQueue(unsafe.Pointer(uintptr(idleKey)))
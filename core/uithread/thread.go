@@ -3,15 +3,12 @@
 package uithread
 
 import (
-	"runtime"
+	"log"
+	"runtime/debug"
 	"sync"
 	"sync/atomic"
-	"unsafe"
 )
 
-// uiThreadID tracks the ID of the UI thread
-var uiThreadID int64
-
 // dispatchQueue is a channel for functions to be executed on the UI thread
 var dispatchQueue = make(chan func(), 100)
 
@@ -25,12 +22,101 @@ var (
 // of the idle function that executes callbacks on the UI thread
 var RegisterIdleHandler func(fn func())
 
+// RegisterUIThreadCheck allows the GTK package to register its implementation
+// of the check whether the caller is running on the UI thread. Go has no
+// portable way to identify OS threads, so without it IsUIThread always
+// returns false.
+var RegisterUIThreadCheck func() bool
+
 // Global variables to manage idle functions
 var (
 	idleFunctions = sync.Map{}
 	nextIdleKey   = atomic.Uint64{}
 )
 
+// pendingCount counts functions handed to the idle handler that haven't run
+// or been cancelled yet
+var pendingCount atomic.Int64
+
+// queuedCount counts functions sent to the dispatch queue until they have
+// been handed to the idle handler, which tracks them from then on. Unlike
+// len(dispatchQueue), it includes the function being handed off.
+var queuedCount atomic.Int64
+
+// PanicHandler receives the value and stack trace of a panic recovered
+// from a function run on the UI thread
+type PanicHandler func(value interface{}, stack []byte)
+
+// panicHandler is the current PanicHandler, stored as a PanicHandler
+var panicHandler atomic.Value
+
+// SetPanicHandler sets the handler that reports panics recovered from
+// functions run on the UI thread. nil restores the default, which writes
+// the panic and its stack trace with the log package.
+func SetPanicHandler(handler PanicHandler) {
+	if handler == nil {
+		handler = logPanic
+	}
+	panicHandler.Store(handler)
+}
+
+// logPanic is the default PanicHandler
+func logPanic(value interface{}, stack []byte) {
+	log.Printf("uithread: recovered panic in UI thread function: %v\n%s", value, stack)
+}
+
+// QueueDepth returns the number of functions waiting to run on the UI
+// thread, for diagnostics such as detecting a blocked main loop. A function
+// is counted from the moment RunOnUIThread queues it until it has run, so a
+// depth of zero means that all queued work is done.
+func QueueDepth() int {
+	return int(queuedCount.Load() + pendingCount.Load())
+}
+
+// Track counts fn as waiting to run on the UI thread until run or cancel
+// is called, whichever comes first. run calls fn with panic recovery, see
+// Protect. Idle handlers use it so that QueueDepth includes their functions.
+func Track(fn func()) (run func(), cancel func()) {
+	pendingCount.Add(1)
+	var done atomic.Bool
+
+	run = func() {
+		if done.CompareAndSwap(false, true) {
+			pendingCount.Add(-1)
+		}
+		Protect(fn)
+	}
+	cancel = func() {
+		if done.CompareAndSwap(false, true) {
+			pendingCount.Add(-1)
+		}
+	}
+	return run, cancel
+}
+
+// Protect calls fn and recovers from a panic in it, reporting the panic and
+// its stack trace to the panic handler instead of crashing the process.
+// runtime.Goexit is not intercepted.
+func Protect(fn func()) {
+	returned := false
+	defer func() {
+		if returned {
+			return
+		}
+		// recover returns nil while runtime.Goexit unwinds, which lets it continue
+		if value := recover(); value != nil {
+			handler, _ := panicHandler.Load().(PanicHandler)
+			if handler == nil {
+				handler = logPanic
+			}
+			handler(value, debug.Stack())
+		}
+	}()
+
+	fn()
+	returned = true
+}
+
 // Initialize initializes the UI thread handling system
 func Initialize() {
 	initMutex.Lock()
@@ -42,10 +128,6 @@ func Initialize() {
 
 	// Platform-specific initialization is performed in the init function
 	// of the platform-specific files (thread_darwin.go, thread_linux.go)
-	
-	// Store the UI thread ID - this must be done on the main thread
-	// Note that on macOS, the OS thread is already locked by the platform-specific init
-	uiThreadID = threadID()
 
 	// Initialize platform-specific idle handler
 	initPlatformIdleHandler()
@@ -56,9 +138,13 @@ func Initialize() {
 	initialized = true
 }
 
-// IsUIThread returns true if the current goroutine is running on the UI thread
+// IsUIThread returns true if the current goroutine is running on the UI
+// thread, as reported by the check registered with RegisterUIThreadCheck
 func IsUIThread() bool {
-	return threadID() == atomic.LoadInt64(&uiThreadID)
+	if RegisterUIThreadCheck != nil {
+		return RegisterUIThreadCheck()
+	}
+	return false
 }
 
 // RunOnUIThread schedules a function to be executed on the UI thread.
@@ -68,6 +154,7 @@ func RunOnUIThread(fn func()) {
 		fn()
 		return
 	}
+	queuedCount.Add(1)
 	dispatchQueue <- fn
 }
 
@@ -78,17 +165,6 @@ func MustRunOnUIThread() {
 	}
 }
 
-// threadID returns a unique identifier for the current OS thread
-func threadID() int64 {
-	var id int64
-	// This func will be executed on the current OS thread
-	runtime.LockOSThread()
-	defer runtime.UnlockOSThread()
-	// Use the memory address of a local variable as a proxy for thread ID
-	id = int64(uintptr(unsafe.Pointer(&id)))
-	return id
-}
-
 // processDispatchQueue processes functions in the dispatch queue
 func processDispatchQueue() {
 	for fn := range dispatchQueue {
@@ -97,8 +173,9 @@ func processDispatchQueue() {
 			RegisterIdleHandler(fn)
 		} else {
 			// Direct call is less ideal but works as fallback
-			fn()
+			Protect(fn)
 		}
+		queuedCount.Add(-1)
 	}
 }
 
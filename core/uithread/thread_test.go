@@ -0,0 +1,156 @@
+package uithread_test
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/justyntemme/gtk4go/core/uithread"
+)
+
+func TestProtectRecoversPanic(t *testing.T) {
+	var (
+		gotValue interface{}
+		gotStack []byte
+	)
+	uithread.SetPanicHandler(func(value interface{}, stack []byte) {
+		gotValue, gotStack = value, stack
+	})
+	defer uithread.SetPanicHandler(nil)
+
+	uithread.Protect(func() {
+		panic("boom")
+	})
+
+	if gotValue != "boom" {
+		t.Fatalf("panic handler got %v, want %q", gotValue, "boom")
+	}
+	if !strings.Contains(string(gotStack), "TestProtectRecoversPanic") {
+		t.Errorf("stack trace doesn't include the panicking function:\n%s", gotStack)
+	}
+}
+
+func TestProtectWithoutPanic(t *testing.T) {
+	called := false
+	uithread.SetPanicHandler(func(interface{}, []byte) {
+		t.Error("panic handler called without a panic")
+	})
+	defer uithread.SetPanicHandler(nil)
+
+	uithread.Protect(func() {
+		called = true
+	})
+
+	if !called {
+		t.Fatal("Protect didn't call the function")
+	}
+}
+
+func TestProtectLetsGoexitThrough(t *testing.T) {
+	uithread.SetPanicHandler(func(interface{}, []byte) {
+		t.Error("panic handler called for runtime.Goexit")
+	})
+	defer uithread.SetPanicHandler(nil)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	after := false
+	go func() {
+		defer wg.Done()
+		uithread.Protect(func() {
+			runtime.Goexit()
+		})
+		after = true
+	}()
+	wg.Wait()
+
+	if after {
+		t.Error("runtime.Goexit was intercepted by Protect")
+	}
+}
+
+func TestTrackQueueDepth(t *testing.T) {
+	base := uithread.QueueDepth()
+
+	calls := 0
+	run, cancel := uithread.Track(func() {
+		calls++
+	})
+	if got := uithread.QueueDepth(); got != base+1 {
+		t.Fatalf("QueueDepth after Track = %d, want %d", got, base+1)
+	}
+
+	run()
+	if got := uithread.QueueDepth(); got != base {
+		t.Fatalf("QueueDepth after run = %d, want %d", got, base)
+	}
+
+	// Neither a cancel after run nor a second run counts again
+	cancel()
+	run()
+	if got := uithread.QueueDepth(); got != base {
+		t.Fatalf("QueueDepth after cancel = %d, want %d", got, base)
+	}
+	if calls != 2 {
+		t.Errorf("function called %d times, want 2", calls)
+	}
+}
+
+func TestTrackCancel(t *testing.T) {
+	base := uithread.QueueDepth()
+
+	_, cancel := uithread.Track(func() {
+		t.Error("cancelled function was called")
+	})
+	cancel()
+	cancel()
+
+	if got := uithread.QueueDepth(); got != base {
+		t.Fatalf("QueueDepth after cancel = %d, want %d", got, base)
+	}
+}
+
+func TestTrackRecoversPanic(t *testing.T) {
+	var gotValue interface{}
+	uithread.SetPanicHandler(func(value interface{}, stack []byte) {
+		gotValue = value
+	})
+	defer uithread.SetPanicHandler(nil)
+
+	run, _ := uithread.Track(func() {
+		panic("tracked")
+	})
+	run()
+
+	if gotValue != "tracked" {
+		t.Fatalf("panic handler got %v, want %q", gotValue, "tracked")
+	}
+}
+
+func TestQueueDepthCountsDispatchedWork(t *testing.T) {
+	// Without an idle handler the dispatcher runs functions itself; a
+	// function must be counted until it has run, including the hand-off
+	release := make(chan struct{})
+	done := make(chan struct{})
+	uithread.RunOnUIThread(func() {
+		<-release
+		close(done)
+	})
+
+	if got := uithread.QueueDepth(); got < 1 {
+		t.Errorf("QueueDepth while the function is queued or running = %d, want at least 1", got)
+	}
+	close(release)
+	<-done
+
+	// The count drops right after the function returns
+	deadline := time.Now().Add(5 * time.Second)
+	for uithread.QueueDepth() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("QueueDepth after the function ran = %d, want 0", uithread.QueueDepth())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -337,7 +337,7 @@ func loadAppStyles() error {
 		return fmt.Errorf("failed to load CSS: %v", err.Error())
 	} else {
 		// Apply CSS provider to the entire application
-		gtk4.AddProviderForDisplay(cssProvider, 600)
+		gtk4.AddProviderForDisplayWithPriority(cssProvider, gtk4.StyleProviderPriorityApplication)
 	}
 	return nil
 }
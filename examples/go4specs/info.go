@@ -57,32 +57,19 @@ func formatBytes(bytes uint64) string {
 	}
 }
 
-// startAutoRefreshTimer starts the auto-refresh timer
-func startAutoRefreshTimer() {
-	if autoRefreshTimer != nil {
-		autoRefreshTimer.Stop()
-	}
-
-	autoRefreshTimer = time.AfterFunc(time.Duration(AUTO_REFRESH_INTERVAL)*time.Second, func() {
-		// Only refresh if auto-refresh is enabled and not currently refreshing
-		if autoRefreshEnabled && refreshAtomicFlag.Load() == 0 {
-			// Ensure we call refreshAllData on the UI thread
-			gtk4go.RunOnUIThread(func() {
-				refreshAllData()
-			})
-		} else {
-			// Restart timer anyway
-			startAutoRefreshTimer()
-		}
-	})
-}
-
 // refreshAllData updates all system information
 func refreshAllData() {
+	refreshAllDataThen(func() {})
+}
+
+// refreshAllDataThen updates all system information in the background and
+// calls done on the UI thread once the refresh has finished or was skipped
+func refreshAllDataThen(done func()) {
 	// Use atomic operation to check and set isRefreshing
 	// This ensures only one refresh can happen at a time
 	if !refreshAtomicFlag.CompareAndSwap(0, 1) {
 		// Another refresh is already in progress
+		done()
 		return
 	}
 
@@ -112,6 +99,7 @@ func refreshAllData() {
 	}, func(result interface{}, err error) {
 		// Reset the refreshing flag when done
 		refreshAtomicFlag.Store(0)
+		defer done()
 
 		// This runs on the UI thread
 		lastRefreshTime = time.Now()
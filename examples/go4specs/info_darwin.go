@@ -138,7 +138,10 @@ func getCPUUsage() (float64, error) {
 	return 0, fmt.Errorf("CPU usage not found")
 }
 
-// getMemoryInfo gets memory information (total, used, free) on macOS
+// getMemoryInfo gets memory information (total, used, free) on macOS.
+// macOS keeps recently used data in inactive pages, which it reclaims on
+// demand, so like MemAvailable on Linux these count as available: used is
+// total minus free, speculative and inactive pages.
 func getMemoryInfo() (uint64, uint64, uint64, error) {
 	// Get total memory
 	totalOutput, err := executeCommand("sysctl", "-n", "hw.memsize")
@@ -157,45 +160,70 @@ func getMemoryInfo() (uint64, uint64, uint64, error) {
 		return 0, 0, 0, err
 	}
 
-	// Parse vm_stat output
-	pageSize := uint64(4096) // Default page size
-	free := uint64(0)
+	// Parse vm_stat output. Pages are 16 KB on Apple silicon and 4 KB on
+	// Intel; the header line states the size
+	pageSize := readPageSize(vmStatOutput)
+	var freePages, speculativePages, inactivePages uint64
 
 	scanner := bufio.NewScanner(strings.NewReader(vmStatOutput))
 	for scanner.Scan() {
 		line := scanner.Text()
 
-		// Get "Pages free"
-		if strings.HasPrefix(line, "Pages free:") {
-			parts := strings.Split(line, ":")
-			if len(parts) == 2 {
-				valueStr := strings.TrimSpace(strings.TrimSuffix(parts[1], "."))
-				value, err := strconv.ParseUint(valueStr, 10, 64)
-				if err == nil {
-					free += value * pageSize
-				}
-			}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		valueStr := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(parts[1]), "."))
+		value, err := strconv.ParseUint(valueStr, 10, 64)
+		if err != nil {
+			continue
 		}
 
-		// Also include "Pages inactive" for a better estimate of free memory
-		if strings.HasPrefix(line, "Pages inactive:") {
-			parts := strings.Split(line, ":")
-			if len(parts) == 2 {
-				valueStr := strings.TrimSpace(strings.TrimSuffix(parts[1], "."))
-				value, err := strconv.ParseUint(valueStr, 10, 64)
-				if err == nil {
-					free += value * pageSize
-				}
-			}
+		switch parts[0] {
+		case "Pages free":
+			freePages = value
+		case "Pages speculative":
+			speculativePages = value
+		case "Pages inactive":
+			inactivePages = value
 		}
 	}
 
-	// Calculate used memory
-	used := total - free
+	free := (freePages + speculativePages) * pageSize
+	available := free + inactivePages*pageSize
+
+	// Calculate used memory, guarding against inconsistent snapshots
+	var used uint64
+	if available < total {
+		used = total - available
+	}
 
 	return total, used, free, nil
 }
 
+// readPageSize returns the page size stated in vm_stat's header, e.g.
+// "Mach Virtual Memory Statistics: (page size of 16384 bytes)", falling
+// back to the hw.pagesize sysctl and then to 4 KB
+func readPageSize(vmStatOutput string) uint64 {
+	const marker = "page size of "
+	if i := strings.Index(vmStatOutput, marker); i >= 0 {
+		fields := strings.Fields(vmStatOutput[i+len(marker):])
+		if len(fields) > 0 {
+			if size, err := strconv.ParseUint(fields[0], 10, 64); err == nil && size > 0 {
+				return size
+			}
+		}
+	}
+
+	if output, err := executeCommand("sysctl", "-n", "hw.pagesize"); err == nil {
+		if size, err := strconv.ParseUint(strings.TrimSpace(output), 10, 64); err == nil && size > 0 {
+			return size
+		}
+	}
+
+	return 4096
+}
+
 // getSwapInfo gets swap information (total, used, free) on macOS
 func getSwapInfo() (uint64, uint64, uint64, error) {
 	// Get swap info using sysctl
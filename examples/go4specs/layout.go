@@ -45,19 +45,12 @@ func createStatusBar() *gtk4.Box {
 
 	// Connect button to toggle auto-refresh state
 	autoRefreshButton.ConnectClicked(func() {
-		autoRefreshEnabled = !autoRefreshEnabled
-		if autoRefreshEnabled {
+		enabled := !autoRefresher.IsEnabled()
+		autoRefresher.SetEnabled(enabled)
+		if enabled {
 			autoRefreshButton.SetLabel("Auto-refresh: On")
-			// Start auto-refresh if interval is set
-			if AUTO_REFRESH_INTERVAL > 0 {
-				startAutoRefreshTimer()
-			}
 		} else {
 			autoRefreshButton.SetLabel("Auto-refresh: Off")
-			// Stop auto-refresh timer
-			if autoRefreshTimer != nil {
-				autoRefreshTimer.Stop()
-			}
 		}
 	})
 
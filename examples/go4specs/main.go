@@ -55,16 +55,15 @@ func (lm *labelMap) update(key string, value string) {
 
 // Global variables for data and UI state
 var (
-	osLabels           *labelMap
-	cpuLabels          *labelMap
-	memoryLabels       *labelMap
-	diskLabels         *labelMap
-	gpuLabels          *labelMap
-	statusLabel        *gtk4.Label
-	autoRefreshEnabled bool = true
-	lastRefreshTime    time.Time
-	autoRefreshTimer   *time.Timer
-	appInstance        *gtk4.Application // Store application instance globally
+	osLabels        *labelMap
+	cpuLabels       *labelMap
+	memoryLabels    *labelMap
+	diskLabels      *labelMap
+	gpuLabels       *labelMap
+	statusLabel     *gtk4.Label
+	lastRefreshTime time.Time
+	autoRefresher   *gtk4go.AutoRefresher
+	appInstance     *gtk4.Application // Store application instance globally
 
 	// Thread-safety improvements
 	refreshAtomicFlag atomic.Int32 // 0 = not refreshing, 1 = refreshing
@@ -91,6 +90,10 @@ func main() {
 	})
 	app.GetActionGroup().AddAction(refreshAction)
 
+	// Create the auto-refresher; it starts a refresh on the UI thread and
+	// skips ticks until the background work of the previous one is done
+	autoRefresher = gtk4go.NewAsyncAutoRefresher(time.Duration(AUTO_REFRESH_INTERVAL)*time.Second, refreshAllDataThen)
+
 	// Create window
 	win := gtk4.NewWindow(TITLE)
 	win.SetDefaultSize(DEFAULT_WIDTH, DEFAULT_HEIGHT)
@@ -104,19 +107,15 @@ func main() {
 	// Set up window close handler
 	win.ConnectCloseRequest(func() bool {
 		// Clean up resources
-		if autoRefreshTimer != nil {
-			autoRefreshTimer.Stop()
-		}
+		autoRefresher.Stop()
 		return false // Return false to allow window to close
 	})
 
 	// Add window to application
 	app.AddWindow(win)
 
-	// Start auto-refresh timer if enabled
-	if AUTO_REFRESH_INTERVAL > 0 {
-		startAutoRefreshTimer()
-	}
+	// Start auto-refresh (a zero interval keeps it idle)
+	autoRefresher.Start()
 
 	// Run the application
 	os.Exit(app.Run())
@@ -8,12 +8,41 @@ package gtk4
 //
 // // Export our action activation callback
 // extern void actionActivateCallback(GSimpleAction *action, GVariant *parameter, gpointer user_data);
+// extern void actionStateCallback(GObject *object, GParamSpec *pspec, gpointer user_data);
+// extern void actionParameterCallback(GSimpleAction *action, GVariant *parameter, gpointer user_data);
 //
 // // Create simple action without connecting a callback
 // static GSimpleAction* createSimpleAction(const char* name) {
 //     return g_simple_action_new(name, NULL);
 // }
 //
+// // Create a stateful action. Boolean actions toggle their state when
+// // activated; other actions take a target of the state's type and switch to it.
+// static GSimpleAction* createStatefulAction(const char* name, GVariant *state) {
+//     const GVariantType *parameter_type = NULL;
+//     if (!g_variant_is_of_type(state, G_VARIANT_TYPE_BOOLEAN)) {
+//         parameter_type = g_variant_get_type(state);
+//     }
+//     return g_simple_action_new_stateful(name, parameter_type, state);
+// }
+//
+// // Create an action that is activated with a parameter of the given type
+// static GSimpleAction* createParameterizedAction(const char* name, const char* parameter_type) {
+//     return g_simple_action_new(name, G_VARIANT_TYPE(parameter_type));
+// }
+//
+// static gboolean isValidVariantType(const char* type_string) {
+//     return g_variant_type_string_is_valid(type_string);
+// }
+//
+// static gulong connectActionParameter(GSimpleAction *action, guint callbackId) {
+//     return g_signal_connect(action, "activate", G_CALLBACK(actionParameterCallback), GUINT_TO_POINTER(callbackId));
+// }
+//
+// static gulong connectActionState(GSimpleAction *action, guint callbackId) {
+//     return g_signal_connect(action, "notify::state", G_CALLBACK(actionStateCallback), GUINT_TO_POINTER(callbackId));
+// }
+//
 // // Add action to action map
 // static void addActionToMap(GActionMap *map, GAction *action) {
 //     g_action_map_add_action(map, action);
@@ -24,6 +53,18 @@ package gtk4
 //     gtk_application_set_menubar(app, menu_model);
 // }
 //
+// // Set the accelerators for an action from a NULL-terminated array
+// static void setAccelsForAction(GtkApplication* app, const char* name, const char** accels) {
+//     gtk_application_set_accels_for_action(app, name, accels);
+// }
+//
+// // Check that an accelerator string can be parsed
+// static gboolean acceleratorIsValid(const char* accel) {
+//     guint key = 0;
+//     GdkModifierType mods = 0;
+//     return gtk_accelerator_parse(accel, &key, &mods) && key != 0;
+// }
+//
 // // Helper function to explicitly connect the activate signal
 // static gulong connectActionActivate(GSimpleAction *action, gpointer callback_data) {
 //     return g_signal_connect(action, "activate", G_CALLBACK(actionActivateCallback), callback_data);
@@ -31,12 +72,29 @@ package gtk4
 import "C"
 
 import (
+	"fmt"
 	"unsafe"
 )
 
 // ActionCallback represents a callback for action activation
 type ActionCallback func()
 
+// SignalActionStateChanged is emitted when the state of a stateful action changes
+const SignalActionStateChanged SignalType = "notify::state"
+
+// VariantType is a GVariant type string describing the parameter of an action
+type VariantType string
+
+// Variant types supported for action parameters and states
+const (
+	VariantTypeBool   VariantType = "b"
+	VariantTypeString VariantType = "s"
+	VariantTypeInt    VariantType = "i"
+	VariantTypeInt64  VariantType = "x"
+	VariantTypeUint   VariantType = "u"
+	VariantTypeDouble VariantType = "d"
+)
+
 // ActionGroup represents a GTK action group
 type ActionGroup interface {
 	AddAction(action *Action)
@@ -88,11 +146,119 @@ func NewAction(name string, callback ActionCallback) *Action {
 	return a
 }
 
+// NewStatefulAction creates a new action with a state, which may be a bool,
+// string, int, int32, int64, uint32 or float64. Menu items for a boolean
+// action are shown as check items, and activating the action toggles the
+// state. Actions with other states are activated with a target of the same
+// type, which becomes the new state. onChange, if not nil, is called with the
+// new state whenever it changes, so that it sees changes made from menus as
+// well as SetState. Returns nil if the state type is unsupported.
+func NewStatefulAction(name string, initial interface{}, onChange func(state interface{})) *Action {
+	state, err := variantFromValue(initial)
+	if err != nil {
+		DebugLog(DebugLevelError, DebugComponentAction, "NewStatefulAction %s: %v", name, err)
+		return nil
+	}
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	a := &Action{
+		action: C.createStatefulAction(cName, state),
+		name:   name,
+	}
+
+	if onChange != nil {
+		a.ConnectStateChanged(onChange)
+	}
+
+	return a
+}
+
+// NewParameterizedAction creates a new action that is activated with a
+// parameter of the given type, e.g. by menu items created with
+// NewMenuItemWithTarget. The handler receives the parameter as a bool,
+// string, int, int64, uint32 or float64 matching the type, or nil for other
+// types. Returns nil if the type is invalid.
+func NewParameterizedAction(name string, paramType VariantType, handler func(param interface{})) *Action {
+	cType := C.CString(string(paramType))
+	defer C.free(unsafe.Pointer(cType))
+
+	if C.isValidVariantType(cType) == C.FALSE {
+		DebugLog(DebugLevelError, DebugComponentAction,
+			"NewParameterizedAction %s: invalid parameter type %q", name, paramType)
+		return nil
+	}
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	a := &Action{
+		action: C.createParameterizedAction(cName, cType),
+		name:   name,
+	}
+
+	if handler != nil {
+		connectCustomSignal(a, SignalActionActivate, handler, func(id C.guint) C.gulong {
+			return C.connectActionParameter(a.action, id)
+		})
+	}
+
+	return a
+}
+
+// GetState returns the state of a stateful action, or nil for actions without state
+func (a *Action) GetState() interface{} {
+	state := C.g_action_get_state(a.GetNative())
+	if state == nil {
+		return nil
+	}
+	defer C.g_variant_unref(state)
+
+	return valueFromVariant(state)
+}
+
+// SetState changes the state of a stateful action, which updates the menu
+// items showing it. The value must have the same type as the initial state.
+func (a *Action) SetState(value interface{}) error {
+	current := C.g_action_get_state(a.GetNative())
+	if current == nil {
+		return &GTKError{Op: "SetState", Err: fmt.Errorf("action %s has no state", a.name)}
+	}
+	defer C.g_variant_unref(current)
+
+	state, err := variantFromValue(value)
+	if err != nil {
+		return err
+	}
+
+	if C.GoString(C.g_variant_get_type_string(state)) != C.GoString(C.g_variant_get_type_string(current)) {
+		// Release the unused floating reference
+		C.g_variant_unref(state)
+		return &GTKError{Op: "SetState", Err: fmt.Errorf("cannot set %T as state of action %s", value, a.name)}
+	}
+
+	C.g_simple_action_set_state(a.action, state)
+	return nil
+}
+
+// ConnectStateChanged connects a callback for changes of the action's state
+func (a *Action) ConnectStateChanged(callback func(state interface{})) uint64 {
+	return connectCustomSignal(a, SignalActionStateChanged, callback, func(id C.guint) C.gulong {
+		return C.connectActionState(a.action, id)
+	})
+}
+
 // GetNative returns the underlying GAction pointer
 func (a *Action) GetNative() *C.GAction {
 	return (*C.GAction)(unsafe.Pointer(a.action))
 }
 
+// Native returns the underlying GSimpleAction pointer as uintptr
+func (a *Action) Native() uintptr {
+	return uintptr(unsafe.Pointer(a.action))
+}
+
 // GetName returns the action name
 func (a *Action) GetName() string {
 	return a.name
@@ -105,7 +271,8 @@ func (a *Action) Free() {
 		// Use the direct action pointer for disconnection
 		actionPtr := uintptr(unsafe.Pointer(a.action))
 
-		// Remove from objectCallbacks map
+		// Disconnect state callbacks, then remove from objectCallbacks map
+		DisconnectAll(a)
 		globalCallbackManager.objectCallbacks.Delete(actionPtr)
 
 		C.g_object_unref(C.gpointer(unsafe.Pointer(a.action)))
@@ -144,77 +311,57 @@ func (a *Application) SetMenuBar(menu *Menu) {
 	C.setApplicationMenuBar(a.app, menu.GetMenuModel())
 }
 
-// Popover represents a GTK popover
-type Popover struct {
-	BaseWidget
-}
+// SetAccelsForAction binds keyboard shortcuts to an action, for example
+// SetAccelsForAction("app.open", "<Control>o"). The action name must include
+// its "app." or "win." prefix. Menus showing the action display the first
+// accelerator automatically. Passing no accelerators removes the bindings.
+func (a *Application) SetAccelsForAction(detailedActionName string, accels ...string) {
+	cName := C.CString(detailedActionName)
+	defer C.free(unsafe.Pointer(cName))
 
-// NewPopover creates a new GTK popover
-func NewPopover() *Popover {
-	popover := &Popover{
-		BaseWidget: BaseWidget{
-			widget: C.gtk_popover_new(),
-		},
+	// Build a NULL-terminated array of accelerator strings
+	cAccels := make([]*C.char, 0, len(accels)+1)
+	for _, accel := range accels {
+		if !IsValidAccelerator(accel) {
+			DebugLog(DebugLevelWarning, DebugComponentAction,
+				"SetAccelsForAction: ignoring invalid accelerator %q for %s", accel, detailedActionName)
+			continue
+		}
+		cAccel := C.CString(accel)
+		defer C.free(unsafe.Pointer(cAccel))
+		cAccels = append(cAccels, cAccel)
 	}
+	cAccels = append(cAccels, nil)
 
-	SetupFinalization(popover, popover.Destroy)
-	return popover
-}
-
-// SetChild sets the child widget for the popover
-func (p *Popover) SetChild(child Widget) {
-	C.gtk_popover_set_child(
-		(*C.GtkPopover)(unsafe.Pointer(p.widget)),
-		child.GetWidget(),
-	)
-}
-
-// SetPointingTo sets the rectangle the popover points to
-func (p *Popover) SetPointingTo(rect *C.GdkRectangle) {
-	C.gtk_popover_set_pointing_to(
-		(*C.GtkPopover)(unsafe.Pointer(p.widget)),
-		rect,
-	)
+	C.setAccelsForAction(a.app, cName, &cAccels[0])
 }
 
-// SetPosition sets the position of the popover relative to the parent widget
-func (p *Popover) SetPosition(position C.GtkPositionType) {
-	C.gtk_popover_set_position(
-		(*C.GtkPopover)(unsafe.Pointer(p.widget)),
-		position,
-	)
-}
+// GetAccelsForAction returns the keyboard shortcuts bound to an action
+func (a *Application) GetAccelsForAction(detailedActionName string) []string {
+	cName := C.CString(detailedActionName)
+	defer C.free(unsafe.Pointer(cName))
 
-// SetAutohide sets whether to hide the popover when clicked outside
-func (p *Popover) SetAutohide(autohide bool) {
-	var cautohide C.gboolean
-	if autohide {
-		cautohide = C.TRUE
-	} else {
-		cautohide = C.FALSE
+	cAccels := C.gtk_application_get_accels_for_action(a.app, cName)
+	if cAccels == nil {
+		return nil
 	}
-	C.gtk_popover_set_autohide(
-		(*C.GtkPopover)(unsafe.Pointer(p.widget)),
-		cautohide,
-	)
-}
+	defer C.g_strfreev(cAccels)
 
-// SetDefaultWidget sets the default widget for the popover
-func (p *Popover) SetDefaultWidget(widget Widget) {
-	C.gtk_popover_set_default_widget(
-		(*C.GtkPopover)(unsafe.Pointer(p.widget)),
-		widget.GetWidget(),
-	)
+	count := int(C.g_strv_length(cAccels))
+	accels := make([]string, 0, count)
+	for _, cAccel := range unsafe.Slice(cAccels, count) {
+		accels = append(accels, C.GoString(cAccel))
+	}
+	return accels
 }
 
-// Popup shows the popover
-func (p *Popover) Popup() {
-	C.gtk_popover_popup((*C.GtkPopover)(unsafe.Pointer(p.widget)))
-}
+// IsValidAccelerator returns whether the string is a valid accelerator,
+// such as "<Control>o" or "<Shift><Alt>F1"
+func IsValidAccelerator(accel string) bool {
+	cAccel := C.CString(accel)
+	defer C.free(unsafe.Pointer(cAccel))
 
-// Popdown hides the popover
-func (p *Popover) Popdown() {
-	C.gtk_popover_popdown((*C.GtkPopover)(unsafe.Pointer(p.widget)))
+	return C.acceleratorIsValid(cAccel) == C.TRUE
 }
 
 //export actionActivateCallback
@@ -247,6 +394,43 @@ func actionActivateCallback(action *C.GSimpleAction, parameter *C.GVariant, user
 	}
 }
 
+//export actionParameterCallback
+func actionParameterCallback(action *C.GSimpleAction, parameter *C.GVariant, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	if cb, ok := callback.(func(interface{})); ok {
+		SafeCallback(cb, valueFromVariant(parameter))
+	} else {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"actionParameterCallback: callback has wrong type: %T", callback)
+	}
+}
+
+//export actionStateCallback
+func actionStateCallback(object *C.GObject, pspec *C.GParamSpec, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	cb, ok := callback.(func(interface{}))
+	if !ok {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"actionStateCallback: callback has wrong type: %T", callback)
+		return
+	}
+
+	var value interface{}
+	if state := C.g_action_get_state((*C.GAction)(unsafe.Pointer(object))); state != nil {
+		value = valueFromVariant(state)
+		C.g_variant_unref(state)
+	}
+	SafeCallback(cb, value)
+}
+
 // Debug helper to dump all registered action callbacks
 func dumpActionCallbacks() {
 	callbackCount := 0
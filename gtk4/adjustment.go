@@ -5,6 +5,12 @@ package gtk4
 // #cgo pkg-config: gtk4
 // #include <gtk/gtk.h>
 // #include <stdlib.h>
+//
+// extern void adjustmentValueChangedCallback(GtkAdjustment *adjustment, gpointer user_data);
+//
+// static gulong connectAdjustmentValueChanged(GtkAdjustment *adjustment, guint callbackId) {
+//     return g_signal_connect(adjustment, "value-changed", G_CALLBACK(adjustmentValueChangedCallback), GUINT_TO_POINTER(callbackId));
+// }
 import "C"
 
 import (
@@ -17,7 +23,8 @@ type AdjustmentOption func(*Adjustment)
 
 // Adjustment represents a GTK adjustment
 type Adjustment struct {
-	adjustment *C.GtkAdjustment
+	adjustment  *C.GtkAdjustment
+	callbackIDs []uint64 // Callbacks connected through this wrapper, disconnected by Free
 }
 
 // NewAdjustment creates a new GTK adjustment
@@ -33,6 +40,10 @@ func NewAdjustment(value, lower, upper, stepIncrement, pageIncrement, pageSize f
 		),
 	}
 
+	// Take ownership of the floating reference, so that a widget using the
+	// adjustment adds its own reference instead of claiming this one
+	C.g_object_ref_sink(C.gpointer(unsafe.Pointer(adjustment.adjustment)))
+
 	// Apply options
 	for _, option := range options {
 		option(adjustment)
@@ -42,6 +53,20 @@ func NewAdjustment(value, lower, upper, stepIncrement, pageIncrement, pageSize f
 	return adjustment
 }
 
+// wrapAdjustment creates a wrapper holding a reference to an existing
+// adjustment, such as one owned by a widget. Callbacks connected through the
+// wrapper stay connected until it is freed.
+func wrapAdjustment(adjustment *C.GtkAdjustment) *Adjustment {
+	if adjustment == nil {
+		return nil
+	}
+
+	C.g_object_ref(C.gpointer(unsafe.Pointer(adjustment)))
+	a := &Adjustment{adjustment: adjustment}
+	runtime.SetFinalizer(a, (*Adjustment).Free)
+	return a
+}
+
 // WithValue sets the initial value of the adjustment
 func WithValue(value float64) AdjustmentOption {
 	return func(a *Adjustment) {
@@ -83,7 +108,9 @@ func (a *Adjustment) GetValue() float64 {
 	return float64(C.gtk_adjustment_get_value(a.adjustment))
 }
 
-// SetValue sets the value of the adjustment
+// SetValue sets the value of the adjustment, clamped to the range from lower
+// to upper minus the page size. value-changed is only emitted if the clamped
+// value differs from the current one.
 func (a *Adjustment) SetValue(value float64) {
 	C.gtk_adjustment_set_value(a.adjustment, C.gdouble(value))
 }
@@ -138,10 +165,37 @@ func (a *Adjustment) SetPageSize(size float64) {
 	C.gtk_adjustment_set_page_size(a.adjustment, C.gdouble(size))
 }
 
-// ConnectValueChanged connects a callback to the value-changed signal
-func (a *Adjustment) ConnectValueChanged(callback func()) {
-	// Use the unified callback system
-	Connect(a, SignalValueChanged, callback)
+// Configure sets all properties of the adjustment at once, emitting changed
+// only once. The value is clamped as by SetValue.
+func (a *Adjustment) Configure(value, lower, upper, stepIncrement, pageIncrement, pageSize float64) {
+	C.gtk_adjustment_configure(
+		a.adjustment,
+		C.gdouble(value),
+		C.gdouble(lower),
+		C.gdouble(upper),
+		C.gdouble(stepIncrement),
+		C.gdouble(pageIncrement),
+		C.gdouble(pageSize),
+	)
+}
+
+// ConnectValueChanged connects a callback to the value-changed signal, which
+// receives the new value. It is only emitted when the value actually changes.
+func (a *Adjustment) ConnectValueChanged(callback func(value float64)) uint64 {
+	id := connectCustomSignal(a, SignalValueChanged, callback, func(id C.guint) C.gulong {
+		return C.connectAdjustmentValueChanged(a.adjustment, id)
+	})
+	a.callbackIDs = append(a.callbackIDs, id)
+	return id
+}
+
+// ConnectChanged connects a callback to the changed signal, emitted when a
+// property other than the value changes, e.g. the upper bound when the
+// content of a scrolled window grows
+func (a *Adjustment) ConnectChanged(callback func()) uint64 {
+	id := Connect(a, SignalChanged, callback)
+	a.callbackIDs = append(a.callbackIDs, id)
+	return id
 }
 
 // DisconnectValueChanged disconnects the value-changed signal handler
@@ -157,13 +211,41 @@ func (a *Adjustment) DisconnectValueChanged() {
 	}
 }
 
-// Free frees the adjustment
+// Native returns the underlying GtkAdjustment pointer as uintptr
+func (a *Adjustment) Native() uintptr {
+	return uintptr(unsafe.Pointer(a.adjustment))
+}
+
+// Free frees the adjustment, disconnecting the callbacks connected through
+// this wrapper. Callbacks connected through other wrappers of the same
+// adjustment stay connected.
 func (a *Adjustment) Free() {
 	if a.adjustment != nil {
-		// Disconnect all signal handlers
-		DisconnectAll(a)
-		
+		// Skip callbacks that were already disconnected, e.g. by
+		// DisconnectValueChanged
+		for _, id := range a.callbackIDs {
+			if _, ok := globalCallbackManager.callbacks.Load(id); ok {
+				Disconnect(id)
+			}
+		}
+		a.callbackIDs = nil
+
 		C.g_object_unref(C.gpointer(unsafe.Pointer(a.adjustment)))
 		a.adjustment = nil
 	}
-}
\ No newline at end of file
+}
+
+//export adjustmentValueChangedCallback
+func adjustmentValueChangedCallback(adjustment *C.GtkAdjustment, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	if cb, ok := callback.(func(float64)); ok {
+		SafeCallback(cb, float64(C.gtk_adjustment_get_value(adjustment)))
+	} else {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"adjustmentValueChangedCallback: callback has wrong type: %T", callback)
+	}
+}
@@ -0,0 +1,28 @@
+package gtk4
+
+import "testing"
+
+func TestAdjustmentFreeDisconnectsOwnCallbacks(t *testing.T) {
+	onUIThread(t, func() {
+		sw := NewScrolledWindow()
+		first := sw.GetVAdjustment()
+		second := sw.GetVAdjustment()
+
+		firstValue := first.ConnectValueChanged(func(float64) {})
+		firstChanged := first.ConnectChanged(func() {})
+		secondValue := second.ConnectValueChanged(func(float64) {})
+
+		first.Free()
+		if connectedHandler(firstValue) != 0 || connectedHandler(firstChanged) != 0 {
+			t.Error("callbacks connected through a freed wrapper are still connected")
+		}
+		if connectedHandler(secondValue) == 0 {
+			t.Error("freeing a wrapper disconnected a callback of another wrapper")
+		}
+
+		second.Free()
+		if connectedHandler(secondValue) != 0 {
+			t.Error("callback is still connected after its wrapper was freed")
+		}
+	})
+}
@@ -0,0 +1,173 @@
+// Package gtk4 provides widget animation functionality for GTK4
+// File: gtk4go/gtk4/animation.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// extern gboolean animationTickCallback(GtkWidget *widget, GdkFrameClock *frame_clock, gpointer user_data);
+// extern void animationDestroyNotify(gpointer user_data);
+//
+// static guint addAnimationTick(GtkWidget *widget, guint key) {
+//     return gtk_widget_add_tick_callback(widget, animationTickCallback, GUINT_TO_POINTER(key), animationDestroyNotify);
+// }
+import "C"
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Animation represents a running opacity animation on a widget.
+// Animations are driven by the widget's frame clock and run on the UI thread.
+type Animation struct {
+	widget    *C.GtkWidget
+	key       uint64
+	tickID    C.guint
+	from      float64
+	to        float64
+	duration  time.Duration
+	start     int64 // Frame time in microseconds, 0 until the first frame
+	onDone    func()
+	hideDone  bool
+	finished  bool
+	completed bool
+}
+
+var (
+	// animations maps animation keys to running animations
+	animations       sync.Map
+	nextAnimationKey atomic.Uint64
+)
+
+// AnimateOpacity animates the widget's opacity from its current value to the
+// target value over the given duration. Must be called on the UI thread.
+func (w *BaseWidget) AnimateOpacity(to float64, duration time.Duration) *Animation {
+	return startOpacityAnimation(w.widget, w.GetOpacity(), to, duration, false)
+}
+
+// FadeIn makes the widget visible and animates its opacity from 0 to 1.
+// Must be called on the UI thread.
+func (w *BaseWidget) FadeIn(duration time.Duration) *Animation {
+	w.SetOpacity(0)
+	C.gtk_widget_set_visible(w.widget, C.TRUE)
+	return startOpacityAnimation(w.widget, 0, 1, duration, false)
+}
+
+// FadeOut animates the widget's opacity to 0 and hides the widget when the
+// animation completes. Must be called on the UI thread.
+func (w *BaseWidget) FadeOut(duration time.Duration) *Animation {
+	return startOpacityAnimation(w.widget, w.GetOpacity(), 0, duration, true)
+}
+
+// startOpacityAnimation registers a tick callback that drives the animation
+func startOpacityAnimation(widget *C.GtkWidget, from, to float64, duration time.Duration, hideDone bool) *Animation {
+	animation := &Animation{
+		widget:   widget,
+		key:      nextAnimationKey.Add(1),
+		from:     from,
+		to:       to,
+		duration: duration,
+		hideDone: hideDone,
+	}
+
+	if widget == nil {
+		animation.finished = true
+		return animation
+	}
+
+	// Jump straight to the end for zero-length animations
+	if duration <= 0 {
+		animation.finish()
+		return animation
+	}
+
+	animations.Store(animation.key, animation)
+	animation.tickID = C.addAnimationTick(widget, C.guint(animation.key))
+
+	return animation
+}
+
+// ConnectDone sets a callback that is called on the UI thread when the
+// animation completes. It is not called if the animation is cancelled or
+// the widget is destroyed before the animation completes.
+func (a *Animation) ConnectDone(callback func()) {
+	a.onDone = callback
+	if a.completed && callback != nil {
+		callback()
+	}
+}
+
+// Cancel stops the animation, leaving the opacity at its current value.
+// Cancelling a finished animation is a no-op.
+func (a *Animation) Cancel() {
+	if a.finished {
+		return
+	}
+	a.finished = true
+	a.onDone = nil
+
+	// Removing the tick callback triggers the destroy notify, which
+	// removes the animation from the registry
+	C.gtk_widget_remove_tick_callback(a.widget, a.tickID)
+}
+
+// IsFinished returns whether the animation has completed or was cancelled
+func (a *Animation) IsFinished() bool {
+	return a.finished
+}
+
+// finish applies the final state of the animation
+func (a *Animation) finish() {
+	a.finished = true
+	a.completed = true
+	C.gtk_widget_set_opacity(a.widget, C.double(a.to))
+	if a.hideDone {
+		C.gtk_widget_set_visible(a.widget, C.FALSE)
+	}
+	if a.onDone != nil {
+		a.onDone()
+	}
+}
+
+//export animationTickCallback
+func animationTickCallback(widget *C.GtkWidget, frameClock *C.GdkFrameClock, userData C.gpointer) C.gboolean {
+	key := uint64(uintptr(userData))
+	value, ok := animations.Load(key)
+	if !ok {
+		return C.FALSE
+	}
+
+	animation := value.(*Animation)
+	if animation.finished {
+		return C.FALSE
+	}
+
+	now := int64(C.gdk_frame_clock_get_frame_time(frameClock))
+	if animation.start == 0 {
+		animation.start = now
+	}
+
+	progress := float64(now-animation.start) * float64(time.Microsecond) / float64(animation.duration)
+	if progress >= 1 {
+		animation.finish()
+		return C.FALSE
+	}
+
+	opacity := animation.from + (animation.to-animation.from)*progress
+	C.gtk_widget_set_opacity(widget, C.double(opacity))
+
+	return C.TRUE
+}
+
+//export animationDestroyNotify
+func animationDestroyNotify(userData C.gpointer) {
+	// Called when the tick callback is removed, including when the widget is
+	// destroyed mid-animation, so the animation never touches a dead widget
+	key := uint64(uintptr(userData))
+	if value, ok := animations.LoadAndDelete(key); ok {
+		value.(*Animation).finished = true
+	}
+}
@@ -24,6 +24,39 @@ package gtk4
 //     gtk_widget_set_visible(window, TRUE);
 // }
 //
+// // Add a window right away once the application is running, e.g. from an
+// // activate callback, where connecting to activate would be too late
+// static gboolean add_window_now(GtkApplication* app, GtkWidget* window) {
+//     if (!g_application_get_is_registered(G_APPLICATION(app))) {
+//         return FALSE;
+//     }
+//     gtk_window_set_application(GTK_WINDOW(window), app);
+//     gtk_widget_set_visible(window, TRUE);
+//     return TRUE;
+// }
+//
+// extern int applicationCommandLineCallback(GApplication *app, GApplicationCommandLine *cmdline, gpointer user_data);
+//
+// extern void applicationLifecycleCallback(GApplication *app, gpointer user_data);
+//
+// // Connect startup, activate or shutdown, which take no arguments
+// static gulong connectLifecycle(GtkApplication* app, const char* signal, guint callbackId) {
+//     return g_signal_connect(app, signal, G_CALLBACK(applicationLifecycleCallback), GUINT_TO_POINTER(callbackId));
+// }
+//
+// static gulong connectCommandLine(GtkApplication* app, guint callbackId) {
+//     return g_signal_connect(app, "command-line", G_CALLBACK(applicationCommandLineCallback), GUINT_TO_POINTER(callbackId));
+// }
+//
+// // Run the application with arguments copied from Go
+// static int runApplicationWithArgs(GtkApplication* app, int argc, char** argv) {
+//     return g_application_run(G_APPLICATION(app), argc, argv);
+// }
+//
+// static char* stringArrayGet(gchar** array, int index) {
+//     return array[index];
+// }
+//
 // // Connect activate signal
 // static void connect_activate(GtkApplication* app, GtkWidget* window) {
 //     ActivateData* data = malloc(sizeof(ActivateData));
@@ -35,8 +68,36 @@ package gtk4
 import "C"
 
 import (
+	"os"
 	"runtime"
 	"unsafe"
+
+	"github.com/justyntemme/gtk4go"
+	"github.com/justyntemme/gtk4go/core/uithread"
+)
+
+// Application lifecycle signal types
+const (
+	SignalStartup  SignalType = "startup"
+	SignalShutdown SignalType = "shutdown"
+)
+
+// SignalCommandLine is emitted on the primary instance with the arguments of a launch
+const SignalCommandLine SignalType = "command-line"
+
+// ApplicationFlags controls how an application is launched
+type ApplicationFlags int
+
+// Application flags
+const (
+	// ApplicationFlagsNone is the default: the first instance is primary and
+	// later launches just activate it
+	ApplicationFlagsNone ApplicationFlags = C.G_APPLICATION_DEFAULT_FLAGS
+	// ApplicationHandlesCommandLine forwards the arguments of every launch to
+	// the primary instance, which handles them with ConnectCommandLine
+	ApplicationHandlesCommandLine ApplicationFlags = C.G_APPLICATION_HANDLES_COMMAND_LINE
+	// ApplicationNonUnique makes every launch run as its own instance
+	ApplicationNonUnique ApplicationFlags = C.G_APPLICATION_NON_UNIQUE
 )
 
 // ApplicationOption is a function that configures an application
@@ -72,6 +133,13 @@ func WithAction(action *Action) ApplicationOption {
 	}
 }
 
+// WithApplicationFlags sets the application flags at creation time
+func WithApplicationFlags(flags ApplicationFlags) ApplicationOption {
+	return func(a *Application) {
+		a.SetFlags(flags)
+	}
+}
+
 // WithMenuBar sets the application menu bar at creation time
 func WithMenuBar(menu *Menu) ApplicationOption {
 	return func(a *Application) {
@@ -79,21 +147,188 @@ func WithMenuBar(menu *Menu) ApplicationOption {
 	}
 }
 
-// AddWindow adds a window to the application and connects the activate signal
+// AddWindow adds a window to the application. Before Run, the window is shown
+// when the application is activated; once the application is running, e.g.
+// in a ConnectActivate callback, it is shown immediately.
 func (a *Application) AddWindow(window any) {
 	if w, ok := window.(interface{ GetWidget() *C.GtkWidget }); ok {
+		if C.add_window_now(a.app, w.GetWidget()) == C.TRUE {
+			return
+		}
+
 		// Connect the activate signal to handle window display
 		C.connect_activate(a.app, w.GetWidget())
 	}
 }
 
-// Run runs the application
+// connectLifecycleSignal connects a callback that runs synchronously during
+// the emission of startup, activate or shutdown. These signals can't go
+// through the idle queue: shutdown is emitted after the main loop has
+// stopped, and the application quits after activate if it has no window.
+func (a *Application) connectLifecycleSignal(signal SignalType, callback func()) uint64 {
+	return connectCustomSignal(a, signal, callback, func(id C.guint) C.gulong {
+		var handlerID C.gulong
+		WithCString(string(signal), func(cSignal *C.char) {
+			handlerID = C.connectLifecycle(a.app, cSignal, id)
+		})
+		return handlerID
+	})
+}
+
+// ConnectStartup connects a callback for the startup signal, emitted once
+// when the application starts running, before it is activated. Set up
+// application-wide state such as actions and accelerators here.
+func (a *Application) ConnectStartup(callback func()) uint64 {
+	return a.connectLifecycleSignal(SignalStartup, callback)
+}
+
+// ConnectActivate connects a callback for the activate signal, emitted when
+// the application is launched, and again when it is launched while already
+// running. Create and add windows here with AddWindow; the callback runs
+// before the signal returns, so the application doesn't quit for lack of a
+// window.
+func (a *Application) ConnectActivate(callback func()) uint64 {
+	return a.connectLifecycleSignal(SignalActivate, callback)
+}
+
+// ConnectShutdown connects a callback for the shutdown signal, emitted on the
+// UI thread after the last window is closed and before Run returns. The main
+// loop has stopped by then, so the callback runs directly and work scheduled
+// with RunOnUIThread from it never runs. Release resources here, e.g. stop
+// background workers.
+func (a *Application) ConnectShutdown(callback func()) uint64 {
+	return a.connectLifecycleSignal(SignalShutdown, callback)
+}
+
+// SetFlags sets the application flags. Flags can only be changed before Run.
+func (a *Application) SetFlags(flags ApplicationFlags) {
+	C.g_application_set_flags(a.application(), C.GApplicationFlags(flags))
+}
+
+// GetFlags returns the application flags
+func (a *Application) GetFlags() ApplicationFlags {
+	return ApplicationFlags(C.g_application_get_flags(a.application()))
+}
+
+// ConnectCommandLine connects a callback that handles the arguments of each
+// launch, including the program name as in os.Args. It also sets
+// ApplicationHandlesCommandLine, so it must be called before Run. The callback
+// runs in the primary instance, for its own launch and for later launches,
+// which exit with the returned status once it returns. Replaces activation:
+// call Activate from the callback to create or raise the window.
+func (a *Application) ConnectCommandLine(callback func(args []string) int) uint64 {
+	a.SetFlags(a.GetFlags() | ApplicationHandlesCommandLine)
+
+	return connectCustomSignal(a, SignalCommandLine, callback, func(id C.guint) C.gulong {
+		return C.connectCommandLine(a.app, id)
+	})
+}
+
+// Activate activates the application, emitting the activate signal
+func (a *Application) Activate() {
+	C.g_application_activate(a.application())
+}
+
+// SetDefaultIconName sets the name of the themed icon used for windows that
+// don't set their own with Window.SetIconName. It applies to all windows of
+// the process, so set it before creating the first window. If the icon
+// theme has no such icon, no icon is shown.
+func (a *Application) SetDefaultIconName(iconName string) {
+	cIconName := C.CString(iconName)
+	defer C.free(unsafe.Pointer(cIconName))
+	C.gtk_window_set_default_icon_name(cIconName)
+}
+
+// application returns the application as a GApplication
+func (a *Application) application() *C.GApplication {
+	return (*C.GApplication)(unsafe.Pointer(a.app))
+}
+
+// Native returns the underlying GtkApplication pointer as uintptr
+func (a *Application) Native() uintptr {
+	return uintptr(unsafe.Pointer(a.app))
+}
+
+// Run runs the application and returns its exit status. With
+// ApplicationHandlesCommandLine, the process arguments are passed on: a
+// later launch forwards them to the primary instance and returns promptly
+// with the status from its command line callback. Timeouts scheduled with
+// gtk4go.TimeoutAdd or IntervalAdd, and functions scheduled with
+// RunOnUIThread that haven't run, are removed when it returns.
 func (a *Application) Run() int {
-	status := C.g_application_run((*C.GApplication)(unsafe.Pointer(a.app)), 0, nil)
+	// Timeouts and idle calls scheduled on the main loop can't run once it
+	// has stopped
+	defer gtk4go.RemoveAllSources()
+	defer gtk4go.CancelAllUIThreadCalls()
+
+	if a.GetFlags()&ApplicationHandlesCommandLine == 0 {
+		status := C.g_application_run(a.application(), 0, nil)
+		return int(status)
+	}
+
+	// Build a NULL-terminated argv from os.Args
+	argv := make([]*C.char, len(os.Args)+1)
+	for i, arg := range os.Args {
+		argv[i] = C.CString(arg)
+	}
+	defer func() {
+		for _, arg := range argv[:len(os.Args)] {
+			C.free(unsafe.Pointer(arg))
+		}
+	}()
+
+	cArgv := (**C.char)(C.malloc(C.size_t(len(argv)) * C.size_t(unsafe.Sizeof(argv[0]))))
+	defer C.free(unsafe.Pointer(cArgv))
+	copy(unsafe.Slice(cArgv, len(argv)), argv)
+
+	status := C.runApplicationWithArgs(a.app, C.int(len(os.Args)), cArgv)
 	return int(status)
 }
 
 // Destroy destroys the application
 func (a *Application) Destroy() {
 	C.g_object_unref(C.gpointer(unsafe.Pointer(a.app)))
-}
\ No newline at end of file
+}
+
+//export applicationCommandLineCallback
+func applicationCommandLineCallback(app *C.GApplication, cmdline *C.GApplicationCommandLine, userData C.gpointer) C.int {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return 1
+	}
+
+	cb, ok := callback.(func([]string) int)
+	if !ok {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"applicationCommandLineCallback: callback has wrong type: %T", callback)
+		return 1
+	}
+
+	var argc C.int
+	cArgs := C.g_application_command_line_get_arguments(cmdline, &argc)
+	defer C.g_strfreev(cArgs)
+
+	args := make([]string, int(argc))
+	for i := range args {
+		args[i] = C.GoString(C.stringArrayGet(cArgs, C.int(i)))
+	}
+
+	// The exit status is needed before returning, so the callback runs
+	// directly; the signal is emitted on the UI thread
+	return C.int(cb(args))
+}
+
+//export applicationLifecycleCallback
+func applicationLifecycleCallback(app *C.GApplication, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	if cb, ok := callback.(func()); ok {
+		uithread.Protect(cb)
+	} else {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"applicationLifecycleCallback: callback has wrong type: %T", callback)
+	}
+}
@@ -13,14 +13,21 @@ import (
 	"unsafe"
 )
 
+// Object defines the common interface for GObject-based types,
+// including non-widget objects such as models, actions and factories.
+// Every type that signals can be connected to implements Object.
+type Object interface {
+	// Native returns the underlying GObject pointer as uintptr
+	Native() uintptr
+}
+
 // Widget defines the common interface for GTK widgets
 type Widget interface {
+	Object
+
 	// GetWidget returns the underlying GtkWidget pointer
 	GetWidget() *C.GtkWidget
 
-	// Native returns the underlying pointer as uintptr
-	Native() uintptr
-
 	// Destroy releases the widget resources
 	Destroy()
 
@@ -52,11 +59,18 @@ func (w *BaseWidget) Native() uintptr {
 // Destroy destroys the widget
 func (w *BaseWidget) Destroy() {
 	if w.widget != nil {
+		detachControllers(w.widget)
+		clearWidgetData(w.widget)
 		C.gtk_widget_unparent(w.widget)
 		w.widget = nil
 	}
 }
 
+// baseWidget returns the embedded base of a widget wrapper
+func (w *BaseWidget) baseWidget() *BaseWidget {
+	return w
+}
+
 // AddCssClass adds a CSS class to the widget
 func (w *BaseWidget) AddCssClass(className string) {
 	cClassName := C.CString(className)
@@ -110,6 +124,222 @@ func (w *BaseWidget) GetVExpand() bool {
 	return C.gtk_widget_get_vexpand(w.widget) == C.TRUE
 }
 
+// Align defines how a widget is positioned within the space allocated to it
+type Align int
+
+const (
+	// AlignFill stretches the widget to fill the available space
+	AlignFill Align = C.GTK_ALIGN_FILL
+	// AlignStart places the widget at the start of the available space
+	AlignStart Align = C.GTK_ALIGN_START
+	// AlignEnd places the widget at the end of the available space
+	AlignEnd Align = C.GTK_ALIGN_END
+	// AlignCenter centers the widget in the available space
+	AlignCenter Align = C.GTK_ALIGN_CENTER
+	// AlignBaseline aligns the widget to the baseline
+	AlignBaseline Align = C.GTK_ALIGN_BASELINE
+)
+
+// SetHAlign sets the horizontal alignment of the widget
+func (w *BaseWidget) SetHAlign(align Align) {
+	C.gtk_widget_set_halign(w.widget, C.GtkAlign(align))
+}
+
+// SetVAlign sets the vertical alignment of the widget
+func (w *BaseWidget) SetVAlign(align Align) {
+	C.gtk_widget_set_valign(w.widget, C.GtkAlign(align))
+}
+
+// GetHAlign returns the horizontal alignment of the widget
+func (w *BaseWidget) GetHAlign() Align {
+	return Align(C.gtk_widget_get_halign(w.widget))
+}
+
+// GetVAlign returns the vertical alignment of the widget
+func (w *BaseWidget) GetVAlign() Align {
+	return Align(C.gtk_widget_get_valign(w.widget))
+}
+
+// SetSizeRequest sets the minimum size of the widget. A width or height of
+// -1 unsets it, so the widget's natural minimum is used for that dimension.
+// The widget is never made smaller than its natural minimum.
+func (w *BaseWidget) SetSizeRequest(width, height int) {
+	C.gtk_widget_set_size_request(w.widget, C.int(width), C.int(height))
+}
+
+// GetSizeRequest returns the minimum size set with SetSizeRequest; -1 means
+// the dimension is unset
+func (w *BaseWidget) GetSizeRequest() (width, height int) {
+	var cWidth, cHeight C.int
+	C.gtk_widget_get_size_request(w.widget, &cWidth, &cHeight)
+	return int(cWidth), int(cHeight)
+}
+
+// GetAllocatedSize returns the size the widget was given by its parent.
+// It is only known once the widget has been shown and laid out, so it is
+// 0, 0 before that, e.g. right after creating the widget.
+func (w *BaseWidget) GetAllocatedSize() (width, height int) {
+	return int(C.gtk_widget_get_width(w.widget)), int(C.gtk_widget_get_height(w.widget))
+}
+
+// clampMargin converts a margin to C, clamping negative values to 0
+func clampMargin(margin int) C.int {
+	if margin < 0 {
+		DebugLog(DebugLevelWarning, DebugComponentGeneral, "Negative margin %d clamped to 0", margin)
+		return 0
+	}
+	return C.int(margin)
+}
+
+// SetMarginTop sets the space above the widget
+func (w *BaseWidget) SetMarginTop(margin int) {
+	C.gtk_widget_set_margin_top(w.widget, clampMargin(margin))
+}
+
+// GetMarginTop returns the space above the widget
+func (w *BaseWidget) GetMarginTop() int {
+	return int(C.gtk_widget_get_margin_top(w.widget))
+}
+
+// SetMarginBottom sets the space below the widget
+func (w *BaseWidget) SetMarginBottom(margin int) {
+	C.gtk_widget_set_margin_bottom(w.widget, clampMargin(margin))
+}
+
+// GetMarginBottom returns the space below the widget
+func (w *BaseWidget) GetMarginBottom() int {
+	return int(C.gtk_widget_get_margin_bottom(w.widget))
+}
+
+// SetMarginStart sets the space before the widget: on the left, or on the
+// right in right-to-left locales
+func (w *BaseWidget) SetMarginStart(margin int) {
+	C.gtk_widget_set_margin_start(w.widget, clampMargin(margin))
+}
+
+// GetMarginStart returns the space before the widget
+func (w *BaseWidget) GetMarginStart() int {
+	return int(C.gtk_widget_get_margin_start(w.widget))
+}
+
+// SetMarginEnd sets the space after the widget: on the right, or on the
+// left in right-to-left locales
+func (w *BaseWidget) SetMarginEnd(margin int) {
+	C.gtk_widget_set_margin_end(w.widget, clampMargin(margin))
+}
+
+// GetMarginEnd returns the space after the widget
+func (w *BaseWidget) GetMarginEnd() int {
+	return int(C.gtk_widget_get_margin_end(w.widget))
+}
+
+// SetMargin sets the same margin on all four sides of the widget
+func (w *BaseWidget) SetMargin(all int) {
+	w.SetMargins(all, all, all, all)
+}
+
+// SetMargins sets the margins of the widget. Negative margins are clamped
+// to 0.
+func (w *BaseWidget) SetMargins(top, bottom, start, end int) {
+	w.SetMarginTop(top)
+	w.SetMarginBottom(bottom)
+	w.SetMarginStart(start)
+	w.SetMarginEnd(end)
+}
+
+// SetCanTarget sets whether the widget can receive pointer input
+func (w *BaseWidget) SetCanTarget(canTarget bool) {
+	var cCanTarget C.gboolean
+	if canTarget {
+		cCanTarget = C.TRUE
+	} else {
+		cCanTarget = C.FALSE
+	}
+	C.gtk_widget_set_can_target(w.widget, cCanTarget)
+}
+
+// SetVisible shows or hides the widget. Hidden widgets take up no space.
+func (w *BaseWidget) SetVisible(visible bool) {
+	var cvisible C.gboolean
+	if visible {
+		cvisible = C.TRUE
+	} else {
+		cvisible = C.FALSE
+	}
+	C.gtk_widget_set_visible(w.widget, cvisible)
+}
+
+// GetVisible returns whether the widget is set to be visible. It may still
+// not be shown if one of its ancestors is hidden.
+func (w *BaseWidget) GetVisible() bool {
+	return C.gtk_widget_get_visible(w.widget) == C.TRUE
+}
+
+// SetSensitive sets whether the widget responds to input. An insensitive
+// widget and its children are drawn dimmed by the theme, which styles them
+// with the :disabled pseudo-class, so no CSS class is needed to show it.
+func (w *BaseWidget) SetSensitive(sensitive bool) {
+	var csensitive C.gboolean
+	if sensitive {
+		csensitive = C.TRUE
+	} else {
+		csensitive = C.FALSE
+	}
+	C.gtk_widget_set_sensitive(w.widget, csensitive)
+}
+
+// GetSensitive returns whether the widget is set to be sensitive. It may
+// still be insensitive if one of its ancestors is; see IsSensitive.
+func (w *BaseWidget) GetSensitive() bool {
+	return C.gtk_widget_get_sensitive(w.widget) == C.TRUE
+}
+
+// IsSensitive returns whether the widget and all of its ancestors are
+// sensitive, i.e. whether it actually responds to input
+func (w *BaseWidget) IsSensitive() bool {
+	return C.gtk_widget_is_sensitive(w.widget) == C.TRUE
+}
+
+// GrabFocus moves the keyboard focus to the widget, or to its first
+// focusable child. Returns false if the widget can't take the focus, e.g.
+// because it is hidden, insensitive or not focusable.
+func (w *BaseWidget) GrabFocus() bool {
+	return C.gtk_widget_grab_focus(w.widget) == C.TRUE
+}
+
+// HasFocus returns whether the widget has the keyboard focus
+func (w *BaseWidget) HasFocus() bool {
+	return C.gtk_widget_has_focus(w.widget) == C.TRUE
+}
+
+// SetCanFocus sets whether the widget or any of its children can take the
+// keyboard focus
+func (w *BaseWidget) SetCanFocus(canFocus bool) {
+	var cCanFocus C.gboolean
+	if canFocus {
+		cCanFocus = C.TRUE
+	} else {
+		cCanFocus = C.FALSE
+	}
+	C.gtk_widget_set_can_focus(w.widget, cCanFocus)
+}
+
+// GetCanFocus returns whether the widget or any of its children can take
+// the keyboard focus
+func (w *BaseWidget) GetCanFocus() bool {
+	return C.gtk_widget_get_can_focus(w.widget) == C.TRUE
+}
+
+// SetOpacity sets the opacity of the widget, from 0.0 (transparent) to 1.0 (opaque)
+func (w *BaseWidget) SetOpacity(opacity float64) {
+	C.gtk_widget_set_opacity(w.widget, C.double(opacity))
+}
+
+// GetOpacity gets the opacity of the widget
+func (w *BaseWidget) GetOpacity() float64 {
+	return float64(C.gtk_widget_get_opacity(w.widget))
+}
+
 // SetChild sets the child widget
 // Note: Not all GTK widgets support this operation directly.
 // For containers like Box, Grid, etc., use their specific methods instead.
@@ -126,6 +356,84 @@ func (w *BaseWidget) SetChild(child Widget) {
 	}
 }
 
+// Compile-time checks that every wrapper type implements the common interfaces
+var (
+	_ Widget = (*AspectFrame)(nil)
+	_ Widget = (*Box)(nil)
+	_ Widget = (*Button)(nil)
+	_ Widget = (*Calendar)(nil)
+	_ Widget = (*CenterBox)(nil)
+	_ Widget = (*ColumnView)(nil)
+	_ Widget = (*Dialog)(nil)
+	_ Widget = (*DrawingArea)(nil)
+	_ Widget = (*DropDown)(nil)
+	_ Widget = (*Entry)(nil)
+	_ Widget = (*Expander)(nil)
+	_ Widget = (*FileDialog)(nil)
+	_ Widget = (*FlowBox)(nil)
+	_ Widget = (*Frame)(nil)
+	_ Widget = (*Grid)(nil)
+	_ Widget = (*GridView)(nil)
+	_ Widget = (*HeaderBar)(nil)
+	_ Widget = (*Image)(nil)
+	_ Widget = (*InfoBar)(nil)
+	_ Widget = (*Label)(nil)
+	_ Widget = (*LabeledSeparator)(nil)
+	_ Widget = (*LevelBar)(nil)
+	_ Widget = (*ListView)(nil)
+	_ Widget = (*MenuBar)(nil)
+	_ Widget = (*MenuButton)(nil)
+	_ Widget = (*MessageDialog)(nil)
+	_ Widget = (*Overlay)(nil)
+	_ Widget = (*Paned)(nil)
+	_ Widget = (*PasswordEntry)(nil)
+	_ Widget = (*Popover)(nil)
+	_ Widget = (*PopoverMenu)(nil)
+	_ Widget = (*ProgressBar)(nil)
+	_ Widget = (*ProgressDialog)(nil)
+	_ Widget = (*Revealer)(nil)
+	_ Widget = (*ScrolledWindow)(nil)
+	_ Widget = (*SearchEntry)(nil)
+	_ Widget = (*SearchableList)(nil)
+	_ Widget = (*Separator)(nil)
+	_ Widget = (*Sparkline)(nil)
+	_ Widget = (*Spinner)(nil)
+	_ Widget = (*Stack)(nil)
+	_ Widget = (*StackSwitcher)(nil)
+	_ Widget = (*ToastOverlay)(nil)
+	_ Widget = (*ToggleButton)(nil)
+	_ Widget = (*Viewport)(nil)
+	_ Widget = (*Window)(nil)
+	_ Widget = (*WindowControls)(nil)
+	_ Widget = (*WindowTitle)(nil)
+
+	_ Object = (*Action)(nil)
+	_ Object = (*Adjustment)(nil)
+	_ Object = (*Application)(nil)
+	_ Object = (*ColumnViewColumn)(nil)
+	_ Object = (*ContentProvider)(nil)
+	_ Object = (*DragSource)(nil)
+	_ Object = (*DropTarget)(nil)
+	_ Object = (*EntryCompletion)(nil)
+	_ Object = (*EventControllerKey)(nil)
+	_ Object = (*EventControllerMotion)(nil)
+	_ Object = (*EventControllerScroll)(nil)
+	_ Object = (*GSettings)(nil)
+	_ Object = (*GestureClick)(nil)
+	_ Object = (*GestureDrag)(nil)
+	_ Object = (*GestureLongPress)(nil)
+	_ Object = (*Menu)(nil)
+	_ Object = (*MultiSelection)(nil)
+	_ Object = (*NoSelection)(nil)
+	_ Object = (*Settings)(nil)
+	_ Object = (*SignalListItemFactory)(nil)
+	_ Object = (*SingleSelection)(nil)
+	_ Object = (*StackPage)(nil)
+	_ Object = (*StackPages)(nil)
+	_ Object = (*StringList)(nil)
+	_ Object = (*ListStore)(nil)
+)
+
 // WithCString executes a function with a C string that is automatically freed
 func WithCString(s string, fn func(*C.char)) {
 	cs := C.CString(s)
@@ -138,8 +446,13 @@ func CastToGObject(widget *C.GtkWidget) *C.GObject {
 	return (*C.GObject)(unsafe.Pointer(widget))
 }
 
-// SetupFinalization sets up proper finalization for a widget
+// SetupFinalization sets up proper finalization for a widget. The wrapper is
+// invalidated if GTK frees the widget first, so destroyFunc doesn't run on
+// freed memory.
 func SetupFinalization(widget Widget, destroyFunc func()) {
+	if base, ok := widget.(interface{ baseWidget() *BaseWidget }); ok {
+		trackWrapper(base.baseWidget())
+	}
 	runtime.SetFinalizer(widget, func(w Widget) {
 		destroyFunc()
 	})
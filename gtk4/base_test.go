@@ -0,0 +1,140 @@
+package gtk4
+
+import (
+	"fmt"
+	"testing"
+)
+
+// connectedHandler returns the GTK handler ID of a connected callback, or
+// 0 if the signal couldn't be connected
+func connectedHandler(id uint64) uint64 {
+	value, ok := globalCallbackManager.callbacks.Load(id)
+	if !ok {
+		return 0
+	}
+	return uint64(value.(*callbackData).handlerID)
+}
+
+func TestConnectSignalToEachWidgetType(t *testing.T) {
+	// Toplevels aren't shown, so they only check that the signal connects
+	tests := []struct {
+		create   func() Widget
+		toplevel bool
+	}{
+		{create: func() Widget { return NewAspectFrame(1, true) }},
+		{create: func() Widget { return NewBox(OrientationVertical, 0) }},
+		{create: func() Widget { return NewButton("Button") }},
+		{create: func() Widget { return NewCalendar() }},
+		{create: func() Widget { return NewCenterBox() }},
+		{create: func() Widget { return NewColumnView(NewSingleSelection(NewStringList())) }},
+		{create: func() Widget { return NewDrawingArea() }},
+		{create: func() Widget { return NewDropDownFromStrings([]string{"a", "b"}) }},
+		{create: func() Widget { return NewEntry() }},
+		{create: func() Widget { return NewExpander("Expander") }},
+		{create: func() Widget { return NewFlowBox() }},
+		{create: func() Widget { return NewFrame("Frame") }},
+		{create: func() Widget { return NewGrid() }},
+		{create: func() Widget {
+			return NewGridView(NewSingleSelection(NewStringList()), NewSignalListItemFactory())
+		}},
+		{create: func() Widget { return NewHeaderBar() }},
+		{create: func() Widget { return NewImageFromIconName("edit-find") }},
+		{create: func() Widget { return NewInfoBar() }},
+		{create: func() Widget { return NewLabel("Label") }},
+		{create: func() Widget { return NewLabeledSeparator("Section") }},
+		{create: func() Widget { return NewLevelBar() }},
+		{create: func() Widget {
+			return NewListView(NewSingleSelection(NewStringList()), NewSignalListItemFactory())
+		}},
+		{create: func() Widget { return NewMenuBar() }},
+		{create: func() Widget { return NewMenuButton() }},
+		{create: func() Widget { return NewOverlay() }},
+		{create: func() Widget { return NewPaned(OrientationHorizontal) }},
+		{create: func() Widget { return NewPasswordEntry() }},
+		{create: func() Widget { return NewProgressBar() }},
+		{create: func() Widget { return NewRevealer() }},
+		{create: func() Widget { return NewScrolledWindow() }},
+		{create: func() Widget { return NewSearchEntry() }},
+		{create: func() Widget { return NewSearchableList([]string{"a", "b"}) }},
+		{create: func() Widget { return NewSeparator(OrientationHorizontal) }},
+		{create: func() Widget { return NewSparkline() }},
+		{create: func() Widget { return NewSpinner() }},
+		{create: func() Widget { return NewStack() }},
+		{create: func() Widget { return NewStackSwitcher(NewStack()) }},
+		{create: func() Widget { return NewToastOverlay() }},
+		{create: func() Widget { return NewToggleButton("Toggle") }},
+		{create: func() Widget { return NewViewport() }},
+		{create: func() Widget { return NewWindowControls(PackTypeStart) }},
+		{create: func() Widget { return NewWindowTitle("Title") }},
+		{create: func() Widget { return NewDialog("Dialog", nil, 0) }, toplevel: true},
+		{create: func() Widget { return NewPopover() }, toplevel: true},
+		{create: func() Widget { return NewPopoverMenu(NewMenu()) }, toplevel: true},
+		{create: func() Widget { return NewWindow("Window") }, toplevel: true},
+	}
+
+	for _, test := range tests {
+		onUIThread(t, func() {
+			widget := test.create()
+			name := fmt.Sprintf("%T", widget)
+			defer DisconnectAll(widget)
+
+			ptr := getObjectPointer(widget)
+			if ptr == 0 || ptr != widget.Native() {
+				t.Errorf("%s: getObjectPointer = %#x, want Native() = %#x", name, ptr, widget.Native())
+				return
+			}
+
+			hidden := false
+			id := Connect(widget, "hide", func() {
+				hidden = true
+			})
+			if connectedHandler(id) == 0 {
+				t.Errorf("%s: hide signal wasn't connected", name)
+				return
+			}
+
+			if !test.toplevel {
+				// Widgets are visible by default, so hiding emits the signal
+				widget.(interface{ SetVisible(bool) }).SetVisible(false)
+				if !hidden {
+					t.Errorf("%s: hide callback didn't run", name)
+				}
+			}
+		})
+	}
+}
+
+func TestConnectSignalToObjects(t *testing.T) {
+	tests := []func() Object{
+		func() Object { return NewAction("action", func() {}) },
+		func() Object { return NewAdjustment(0, 0, 10, 1, 1, 0) },
+		func() Object { return NewEventControllerKey() },
+		func() Object { return NewEventControllerMotion() },
+		func() Object { return NewGestureClick() },
+		func() Object { return NewMultiSelection(NewStringList()) },
+		func() Object { return NewNoSelection(NewStringList()) },
+		func() Object { return NewObjectListStore() },
+		func() Object { return NewSignalListItemFactory() },
+		func() Object { return NewSingleSelection(NewStringList()) },
+		func() Object { return NewStringList() },
+	}
+
+	for _, create := range tests {
+		onUIThread(t, func() {
+			object := create()
+			name := fmt.Sprintf("%T", object)
+			defer DisconnectAll(object)
+
+			ptr := getObjectPointer(object)
+			if ptr == 0 || ptr != object.Native() {
+				t.Errorf("%s: getObjectPointer = %#x, want Native() = %#x", name, ptr, object.Native())
+				return
+			}
+
+			// Every GObject has the notify signal
+			if id := Connect(object, "notify", func() {}); connectedHandler(id) == 0 {
+				t.Errorf("%s: notify signal wasn't connected", name)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Package gtk4 provides bitset functionality for GTK4
+// File: gtk4go/gtk4/bitset.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// // Copy the values of a bitset into an array in ascending order,
+// // visiting only the values that are set
+// static guint bitsetToArray(GtkBitset *bitset, guint *values, guint n_values) {
+//     GtkBitsetIter iter;
+//     guint value;
+//     guint n = 0;
+//     gboolean valid = gtk_bitset_iter_init_first(&iter, bitset, &value);
+//     while (valid && n < n_values) {
+//         values[n++] = value;
+//         valid = gtk_bitset_iter_next(&iter, &value);
+//     }
+//     return n;
+// }
+import "C"
+
+import (
+	"runtime"
+)
+
+// Bitset represents a GTK bitset, a set of unsigned integers such as the
+// selected positions of a selection model. It is stored compactly, so large
+// ranges cost little memory.
+type Bitset struct {
+	bitset *C.GtkBitset
+}
+
+// NewBitset creates a new empty bitset
+func NewBitset() *Bitset {
+	return wrapBitset(C.gtk_bitset_new_empty())
+}
+
+// NewBitsetRange creates a new bitset containing nItems values starting at start
+func NewBitsetRange(start, nItems int) *Bitset {
+	return wrapBitset(C.gtk_bitset_new_range(C.guint(start), C.guint(nItems)))
+}
+
+// wrapBitset takes ownership of a bitset reference
+func wrapBitset(bitset *C.GtkBitset) *Bitset {
+	if bitset == nil {
+		return nil
+	}
+
+	b := &Bitset{bitset: bitset}
+	runtime.SetFinalizer(b, (*Bitset).Destroy)
+	return b
+}
+
+// Contains returns whether the value is in the bitset
+func (b *Bitset) Contains(value int) bool {
+	if value < 0 {
+		return false
+	}
+	return C.gtk_bitset_contains(b.bitset, C.guint(value)) != 0
+}
+
+// IsEmpty returns whether the bitset contains no values
+func (b *Bitset) IsEmpty() bool {
+	return C.gtk_bitset_is_empty(b.bitset) != 0
+}
+
+// GetSize returns the number of values in the bitset
+func (b *Bitset) GetSize() int {
+	return int(C.gtk_bitset_get_size(b.bitset))
+}
+
+// GetMinimum returns the smallest value in the bitset, or -1 if it is empty
+func (b *Bitset) GetMinimum() int {
+	if b.IsEmpty() {
+		return -1
+	}
+	return int(C.gtk_bitset_get_minimum(b.bitset))
+}
+
+// GetMaximum returns the largest value in the bitset, or -1 if it is empty
+func (b *Bitset) GetMaximum() int {
+	if b.IsEmpty() {
+		return -1
+	}
+	return int(C.gtk_bitset_get_maximum(b.bitset))
+}
+
+// Add adds a value to the bitset
+func (b *Bitset) Add(value int) {
+	C.gtk_bitset_add(b.bitset, C.guint(value))
+}
+
+// Remove removes a value from the bitset
+func (b *Bitset) Remove(value int) {
+	C.gtk_bitset_remove(b.bitset, C.guint(value))
+}
+
+// AddRange adds nItems values starting at start to the bitset
+func (b *Bitset) AddRange(start, nItems int) {
+	C.gtk_bitset_add_range(b.bitset, C.guint(start), C.guint(nItems))
+}
+
+// RemoveRange removes nItems values starting at start from the bitset
+func (b *Bitset) RemoveRange(start, nItems int) {
+	C.gtk_bitset_remove_range(b.bitset, C.guint(start), C.guint(nItems))
+}
+
+// RemoveAll removes all values from the bitset
+func (b *Bitset) RemoveAll() {
+	C.gtk_bitset_remove_all(b.bitset)
+}
+
+// ToSlice returns the values of the bitset in ascending order. Only the set
+// values are visited, so this is cheap for small sets even when the values
+// are large.
+func (b *Bitset) ToSlice() []int {
+	size := b.GetSize()
+	if size == 0 {
+		return []int{}
+	}
+
+	values := make([]C.guint, size)
+	n := int(C.bitsetToArray(b.bitset, &values[0], C.guint(size)))
+
+	result := make([]int, n)
+	for i := 0; i < n; i++ {
+		result[i] = int(values[i])
+	}
+	return result
+}
+
+// Destroy releases the bitset
+func (b *Bitset) Destroy() {
+	if b.bitset != nil {
+		C.gtk_bitset_unref(b.bitset)
+		b.bitset = nil
+	}
+}
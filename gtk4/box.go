@@ -81,6 +81,72 @@ func (b *Box) Remove(child Widget) {
 	C.gtk_box_remove((*C.GtkBox)(unsafe.Pointer(b.widget)), child.GetWidget())
 }
 
+// GetFirstChild returns the first child of the box, or nil if it is empty
+func (b *Box) GetFirstChild() Widget {
+	child := C.gtk_widget_get_first_child(b.widget)
+	if child == nil {
+		return nil
+	}
+	wrapper := &BaseWidget{widget: child}
+	trackWrapper(wrapper)
+	return wrapper
+}
+
+// GetChildAt returns the child at the given index, or nil if the index is
+// out of range
+func (b *Box) GetChildAt(index int) Widget {
+	if index < 0 {
+		return nil
+	}
+
+	child := C.gtk_widget_get_first_child(b.widget)
+	for i := 0; child != nil && i < index; i++ {
+		child = C.gtk_widget_get_next_sibling(child)
+	}
+	if child == nil {
+		return nil
+	}
+	wrapper := &BaseWidget{widget: child}
+	trackWrapper(wrapper)
+	return wrapper
+}
+
+// GetChildren returns the children of the box in order. The returned
+// widgets are lightweight wrappers that don't own the children and become
+// invalid (their widget pointer is cleared) once a child is freed; the slice
+// is a snapshot, so the box can be modified while iterating over it.
+func (b *Box) GetChildren() []Widget {
+	children := []Widget{}
+	for child := C.gtk_widget_get_first_child(b.widget); child != nil; child = C.gtk_widget_get_next_sibling(child) {
+		wrapper := &BaseWidget{widget: child}
+		trackWrapper(wrapper)
+		children = append(children, wrapper)
+	}
+	return children
+}
+
+// RemoveAll removes every child from the box. Signal callbacks and data
+// (see SetData) of the children and their descendants are released. Children
+// that aren't referenced elsewhere are freed, which invalidates their
+// wrappers, so destroying or finalizing those wrappers afterwards is a no-op.
+func (b *Box) RemoveAll() {
+	box := (*C.GtkBox)(unsafe.Pointer(b.widget))
+	for child := C.gtk_widget_get_first_child(b.widget); child != nil; child = C.gtk_widget_get_first_child(b.widget) {
+		releaseWidgetTree(child)
+		C.gtk_box_remove(box, child)
+	}
+}
+
+// releaseWidgetTree releases the Go-side callbacks and data of a widget and
+// all of its descendants
+func releaseWidgetTree(widget *C.GtkWidget) {
+	for child := C.gtk_widget_get_first_child(widget); child != nil; child = C.gtk_widget_get_next_sibling(child) {
+		releaseWidgetTree(child)
+	}
+	DisconnectAll(&BaseWidget{widget: widget})
+	clearWidgetData(widget)
+}
+
 // SetSpacing sets the spacing between children
 func (b *Box) SetSpacing(spacing int) {
 	C.gtk_box_set_spacing((*C.GtkBox)(unsafe.Pointer(b.widget)), C.int(spacing))
@@ -117,4 +183,33 @@ func (b *Box) SetVExpand(expand bool) {
 		cexpand = C.FALSE
 	}
 	C.gtk_widget_set_vexpand(b.widget, cexpand)
-}
\ No newline at end of file
+}
+
+// NewIconLabelRow creates a horizontal row with an icon followed by a label,
+// as commonly used for list item rows. It returns the row container and the
+// label, so that the label text can be updated later, e.g. in a factory's
+// bind callback. The row, icon and label get the "icon-label-row",
+// "row-icon" and "row-label" CSS classes for styling.
+func NewIconLabelRow(iconName, text string) (*Box, *Label) {
+	row := NewBox(OrientationHorizontal, 6)
+	row.AddCssClass("icon-label-row")
+
+	// The icon is never updated, so it is added without a Go wrapper whose
+	// finalizer would remove it from the row
+	var icon *C.GtkWidget
+	WithCString(iconName, func(cIconName *C.char) {
+		icon = C.gtk_image_new_from_icon_name(cIconName)
+	})
+	WithCString("row-icon", func(cClassName *C.char) {
+		C.gtk_widget_add_css_class(icon, cClassName)
+	})
+	C.gtk_box_append((*C.GtkBox)(unsafe.Pointer(row.widget)), icon)
+
+	label := NewLabel(text)
+	label.AddCssClass("row-label")
+	label.SetHExpand(true)
+	C.gtk_label_set_xalign((*C.GtkLabel)(unsafe.Pointer(label.widget)), 0)
+	row.Append(label)
+
+	return row, label
+}
@@ -41,6 +41,53 @@ func NewButton(label string, options ...ButtonOption) *Button {
 	return button
 }
 
+// NewButtonWithMnemonic creates a new GTK button whose label may contain a mnemonic,
+// e.g. "_Refresh" activates the button with Alt+R
+func NewButtonWithMnemonic(label string, options ...ButtonOption) *Button {
+	var widget *C.GtkWidget
+
+	WithCString(label, func(cLabel *C.char) {
+		widget = C.gtk_button_new_with_mnemonic(cLabel)
+	})
+
+	button := &Button{
+		BaseWidget: BaseWidget{
+			widget: widget,
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(button)
+	}
+
+	SetupFinalization(button, button.Destroy)
+	return button
+}
+
+// NewButtonFromIconName creates a new GTK button showing a themed icon
+func NewButtonFromIconName(iconName string, options ...ButtonOption) *Button {
+	var widget *C.GtkWidget
+
+	WithCString(iconName, func(cIconName *C.char) {
+		widget = C.gtk_button_new_from_icon_name(cIconName)
+	})
+
+	button := &Button{
+		BaseWidget: BaseWidget{
+			widget: widget,
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(button)
+	}
+
+	SetupFinalization(button, button.Destroy)
+	return button
+}
+
 // WithMnemonic creates a button with mnemonic support
 func WithMnemonic(label string) ButtonOption {
 	return func(b *Button) {
@@ -89,22 +136,43 @@ func (b *Button) Destroy() {
 	b.BaseWidget.Destroy()
 }
 
-// SetIconName sets the icon for the button
+// SetIconName sets the icon for the button, replacing any label or child.
+// To show both an icon and a label, use SetChild with a Box instead.
 func (b *Button) SetIconName(iconName string) {
 	WithCString(iconName, func(cIconName *C.char) {
-		// Create a new image with the icon name
-		image := C.gtk_image_new_from_icon_name(cIconName)
-
-		// Set the image on the button
-		C.gtk_button_set_child((*C.GtkButton)(unsafe.Pointer(b.widget)), image)
+		C.gtk_button_set_icon_name((*C.GtkButton)(unsafe.Pointer(b.widget)), cIconName)
 	})
 }
 
+// GetIconName gets the icon name of the button, or "" if it doesn't show an icon
+func (b *Button) GetIconName() string {
+	cIconName := C.gtk_button_get_icon_name((*C.GtkButton)(unsafe.Pointer(b.widget)))
+	if cIconName == nil {
+		return ""
+	}
+	return C.GoString(cIconName)
+}
+
 // SetChild sets the child widget for the button (instead of a label)
 func (b *Button) SetChild(child Widget) {
+	if child == nil {
+		C.gtk_button_set_child((*C.GtkButton)(unsafe.Pointer(b.widget)), nil)
+		return
+	}
 	C.gtk_button_set_child((*C.GtkButton)(unsafe.Pointer(b.widget)), child.GetWidget())
 }
 
+// SetUseUnderline sets whether an underline in the label indicates a mnemonic
+func (b *Button) SetUseUnderline(useUnderline bool) {
+	var cUseUnderline C.gboolean
+	if useUnderline {
+		cUseUnderline = C.TRUE
+	} else {
+		cUseUnderline = C.FALSE
+	}
+	C.gtk_button_set_use_underline((*C.GtkButton)(unsafe.Pointer(b.widget)), cUseUnderline)
+}
+
 // GetChild gets the child widget of the button
 func (b *Button) GetChild() Widget {
 	widget := C.gtk_button_get_child((*C.GtkButton)(unsafe.Pointer(b.widget)))
@@ -0,0 +1,153 @@
+// Package gtk4 provides calendar functionality for GTK4
+// File: gtk4go/gtk4/calendar.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// // Get the selected date; month is 1-based as in GDateTime
+// static void calendarGetDate(GtkCalendar *calendar, int *year, int *month, int *day) {
+//     GDateTime *date = gtk_calendar_get_date(calendar);
+//     g_date_time_get_ymd(date, year, month, day);
+//     g_date_time_unref(date);
+// }
+//
+// // Select a date; month is 1-based. Returns FALSE for an invalid date.
+// static gboolean calendarSelectDay(GtkCalendar *calendar, int year, int month, int day) {
+//     GDateTime *date = g_date_time_new_local(year, month, day, 0, 0, 0);
+//     if (date == NULL) {
+//         return FALSE;
+//     }
+//     gtk_calendar_select_day(calendar, date);
+//     g_date_time_unref(date);
+//     return TRUE;
+// }
+import "C"
+
+import (
+	"fmt"
+	"time"
+	"unsafe"
+)
+
+// SignalDaySelected is emitted when the user selects a day in a calendar
+const SignalDaySelected SignalType = "day-selected"
+
+// CalendarOption is a function that configures a calendar
+type CalendarOption func(*Calendar)
+
+// Calendar represents a GTK calendar showing one month at a time.
+//
+// Unlike GtkCalendar's month property, which counts from 0, months in this
+// API are 1-based (1 is January), as in the time package.
+type Calendar struct {
+	BaseWidget
+}
+
+// NewCalendar creates a new GTK calendar with today selected
+func NewCalendar(options ...CalendarOption) *Calendar {
+	calendar := &Calendar{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_calendar_new(),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(calendar)
+	}
+
+	SetupFinalization(calendar, calendar.Destroy)
+	return calendar
+}
+
+// WithShowWeekNumbers sets whether week numbers are shown
+func WithShowWeekNumbers(show bool) CalendarOption {
+	return func(c *Calendar) {
+		c.SetShowWeekNumbers(show)
+	}
+}
+
+// calendar returns the widget as a GtkCalendar
+func (c *Calendar) calendar() *C.GtkCalendar {
+	return (*C.GtkCalendar)(unsafe.Pointer(c.widget))
+}
+
+// GetDate returns the selected date. month is 1-based (1 is January).
+func (c *Calendar) GetDate() (year, month, day int) {
+	var cYear, cMonth, cDay C.int
+	C.calendarGetDate(c.calendar(), &cYear, &cMonth, &cDay)
+	return int(cYear), int(cMonth), int(cDay)
+}
+
+// GetTime returns the selected date as midnight in the local time zone
+func (c *Calendar) GetTime() time.Time {
+	year, month, day := c.GetDate()
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.Local)
+}
+
+// SelectDay selects a date and shows its month. month is 1-based (1 is
+// January). Returns an error for a date that doesn't exist, such as
+// February 30.
+func (c *Calendar) SelectDay(year, month, day int) error {
+	if C.calendarSelectDay(c.calendar(), C.int(year), C.int(month), C.int(day)) == C.FALSE {
+		return &GTKError{Op: "Calendar.SelectDay", Err: fmt.Errorf("invalid date %04d-%02d-%02d", year, month, day)}
+	}
+	return nil
+}
+
+// SelectTime selects the date of t in the local time zone. Returns an error
+// like SelectDay for a date GTK can't show, such as one after the year 9999.
+func (c *Calendar) SelectTime(t time.Time) error {
+	year, month, day := t.In(time.Local).Date()
+	return c.SelectDay(year, int(month), day)
+}
+
+// MarkDay marks a day of the shown month, e.g. to highlight days that have
+// entries. Marks belong to day numbers, not dates, so they stay in place
+// when another month is shown; clear them with ClearMarks.
+func (c *Calendar) MarkDay(day int) {
+	C.gtk_calendar_mark_day(c.calendar(), C.guint(day))
+}
+
+// UnmarkDay removes the mark of a day
+func (c *Calendar) UnmarkDay(day int) {
+	C.gtk_calendar_unmark_day(c.calendar(), C.guint(day))
+}
+
+// ClearMarks removes the marks of all days
+func (c *Calendar) ClearMarks() {
+	C.gtk_calendar_clear_marks(c.calendar())
+}
+
+// IsDayMarked returns whether a day is marked
+func (c *Calendar) IsDayMarked(day int) bool {
+	return C.gtk_calendar_get_day_is_marked(c.calendar(), C.guint(day)) == C.TRUE
+}
+
+// SetShowWeekNumbers sets whether week numbers are shown
+func (c *Calendar) SetShowWeekNumbers(show bool) {
+	var cshow C.gboolean
+	if show {
+		cshow = C.TRUE
+	} else {
+		cshow = C.FALSE
+	}
+	C.gtk_calendar_set_show_week_numbers(c.calendar(), cshow)
+}
+
+// ConnectDaySelected connects a callback for when the user selects a day.
+// Use GetDate in the callback to read the selected date.
+func (c *Calendar) ConnectDaySelected(callback func()) uint64 {
+	return Connect(c, SignalDaySelected, callback)
+}
+
+// Destroy destroys the calendar and cleans up resources
+func (c *Calendar) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(c)
+
+	// Call base destroy method
+	c.BaseWidget.Destroy()
+}
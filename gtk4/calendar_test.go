@@ -0,0 +1,25 @@
+package gtk4
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalendarSelectTime(t *testing.T) {
+	onUIThread(t, func() {
+		calendar := NewCalendar()
+
+		date := time.Date(2024, time.February, 29, 15, 30, 0, 0, time.Local)
+		if err := calendar.SelectTime(date); err != nil {
+			t.Errorf("SelectTime(%v): %v", date, err)
+		}
+		if got, want := calendar.GetTime(), time.Date(2024, time.February, 29, 0, 0, 0, 0, time.Local); !got.Equal(want) {
+			t.Errorf("GetTime = %v, want %v", got, want)
+		}
+
+		late := time.Date(10000, time.January, 1, 0, 0, 0, 0, time.Local)
+		if err := calendar.SelectTime(late); err == nil {
+			t.Errorf("SelectTime(%v) didn't return an error", late)
+		}
+	})
+}
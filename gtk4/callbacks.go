@@ -23,6 +23,24 @@ package gtk4
 //     }
 // }
 //
+// extern void closureMarshal(GClosure *closure, GValue *return_value, guint n_param_values, GValue *param_values, gpointer invocation_hint, gpointer marshal_data);
+//
+// // Connect a closure whose marshaller hands all signal arguments to Go as
+// // GValues, for callbacks taking doubles or several arguments
+// static gulong connectSignalClosure(GObject *object, const char *signal, guint callbackId) {
+//     GClosure *closure = g_closure_new_simple(sizeof(GClosure), GUINT_TO_POINTER(callbackId));
+//     g_closure_set_marshal(closure, (GClosureMarshal)closureMarshal);
+//     return g_signal_connect_closure(object, signal, closure, FALSE);
+// }
+//
+// static guint closureCallbackId(GClosure *closure) {
+//     return GPOINTER_TO_UINT(closure->data);
+// }
+//
+// static GValue* gvalueAt(GValue *values, guint index) {
+//     return &values[index];
+// }
+//
 // // Connect tooltip query signal specifically
 // static gulong connectTooltipQuery(GtkWidget *widget, guint callbackId) {
 //     return g_signal_connect(widget, "query-tooltip", G_CALLBACK(tooltipQueryCallback), GUINT_TO_POINTER(callbackId));
@@ -40,10 +58,12 @@ import (
 	"fmt"
 	"reflect"
 	"runtime"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"unsafe"
 
+	"github.com/justyntemme/gtk4go"
 	// Import core uithread package
 	"github.com/justyntemme/gtk4go/core/uithread"
 )
@@ -59,6 +79,8 @@ const (
 	SourceGeneric SignalSource = iota
 	SourceListView
 	SourceAction
+	SourceColumnView
+	SourceGridView
 )
 
 // Common GTK signal types
@@ -156,6 +178,10 @@ func Connect(object interface{}, signal SignalType, callback interface{}) (handl
 	source := SourceGeneric
 	if _, isListView := object.(*ListView); isListView && signal == SignalListActivate {
 		source = SourceListView
+	} else if _, isColumnView := object.(*ColumnView); isColumnView && signal == SignalListActivate {
+		source = SourceColumnView
+	} else if _, isGridView := object.(*GridView); isGridView && signal == SignalListActivate {
+		source = SourceGridView
 	} else if _, isAction := object.(*Action); isAction && signal == SignalActionActivate {
 		source = SourceAction
 	}
@@ -180,6 +206,11 @@ func Connect(object interface{}, signal SignalType, callback interface{}) (handl
 	var handlerId C.gulong
 	if signal == SignalQueryTooltip {
 		handlerId = C.connectTooltipQuery((*C.GtkWidget)(unsafe.Pointer(objectPtr)), C.guint(id))
+	} else if isMultiArgCallback(callback) && !strings.HasPrefix(string(signal), "notify::") {
+		// Doubles and multiple arguments aren't passed in the pointer-sized
+		// parameter of the generic handlers, so read them from GValues.
+		// Typed notify callbacks read the property value instead.
+		handlerId = C.connectSignalClosure(cObject, cSignal, C.guint(id))
 	} else {
 		// Connect regular signal
 		handlerId = C.connectSignal(
@@ -209,6 +240,65 @@ func Connect(object interface{}, signal SignalType, callback interface{}) (handl
 	return id
 }
 
+// connectCustomSignal registers a callback for a signal whose handler has a
+// signature the generic handlers can't marshal (e.g. double arguments).
+// The connect function must connect a dedicated exported handler, passing the
+// given callback ID as user data; the handler then looks the callback up with
+// lookupCallback. The returned ID can be used with Disconnect.
+func connectCustomSignal(object interface{}, signal SignalType, callback interface{}, connect func(id C.guint) C.gulong) uint64 {
+	objectPtr := getObjectPointer(object)
+	if objectPtr == 0 {
+		DebugLog(DebugLevelError, DebugComponentCallback, "connectCustomSignal failed: couldn't get object pointer for %T", object)
+		return 0
+	}
+
+	id := nextCallbackID.Add(1)
+	hasParam, hasReturn := analyzeCallbackSignature(callback)
+
+	data := &callbackData{
+		callback:  callback,
+		objectPtr: objectPtr,
+		signal:    signal,
+		source:    SourceGeneric,
+		hasParam:  hasParam,
+		hasReturn: hasReturn,
+	}
+
+	// Store before connecting so the handler can never miss the callback
+	globalCallbackManager.callbacks.Store(id, data)
+	data.handlerID = connect(C.guint(id))
+
+	globalCallbackManager.trackObjectHandler(objectPtr, data.handlerID)
+	globalCallbackManager.storeObjectCallback(objectPtr, signal, callback)
+
+	DebugLog(DebugLevelInfo, DebugComponentCallback, "Connected custom signal %s with ID %d to object %p",
+		signal, id, objectPtr)
+
+	return id
+}
+
+// lookupCallback returns the callback registered under the ID passed as
+// user data to a custom signal handler
+func lookupCallback(userData C.gpointer) (interface{}, bool) {
+	id := uint64(uintptr(userData))
+	value, ok := globalCallbackManager.callbacks.Load(id)
+	if !ok {
+		DebugLog(DebugLevelWarning, DebugComponentCallback, "lookupCallback: callback ID %d not found", id)
+		return nil, false
+	}
+	return value.(*callbackData).callback, true
+}
+
+// ConnectNotify connects a callback to the "notify::<property>" signal of an object,
+// which is emitted whenever the property changes.
+// Notify handlers receive a GParamSpec argument, so the callback is routed
+// through the parameter path and the GParamSpec is ignored.
+func ConnectNotify(object interface{}, property string, callback func()) uint64 {
+	return Connect(object, SignalType("notify::"+property), func(_ int) {
+		callback()
+	})
+}
+
 // Disconnect disconnects a signal handler by its ID
 func Disconnect(id uint64) {
 	// Look up the callback data
@@ -339,17 +429,17 @@ func (m *CallbackManager) removeObjectCallback(objectPtr uintptr, signal SignalT
 
 // getObjectPointer returns the pointer to the GObject of a GTK widget
 func getObjectPointer(object interface{}) uintptr {
-	// Handle common GTK widget types
+	// All wrapper types implement Widget or Object
 	switch obj := object.(type) {
 	case Widget:
 		return uintptr(unsafe.Pointer(obj.GetWidget()))
-	case *Adjustment:
-		return uintptr(unsafe.Pointer(obj.adjustment))
-	case *Action:
-		return uintptr(unsafe.Pointer(obj.action))
-	case *SignalListItemFactory:
-		return uintptr(unsafe.Pointer(obj.factory))
+	case Object:
+		return obj.Native()
 	default:
+		// Fall back to reflection for types defined outside this package
+		DebugLog(DebugLevelVerbose, DebugComponentCallback,
+			"getObjectPointer: %T implements neither Widget nor Object, using reflection", object)
+
 		// Try to find a GetWidget or Native method using reflection
 		val := reflect.ValueOf(object)
 		if val.Kind() == reflect.Ptr && !val.IsNil() {
@@ -464,6 +554,13 @@ func boolToGBoolean(b bool) C.gboolean {
 	return C.FALSE
 }
 
+// iterateMainLoop runs a single iteration of the default main context and
+// reports whether a source was dispatched. The tests use it to run the main
+// loop without an Application.
+func iterateMainLoop(block bool) bool {
+	return C.g_main_context_iteration(nil, boolToGBoolean(block)) == C.TRUE
+}
+
 // execCallback safely executes a callback on the main UI thread
 // to ensure thread safety with GTK
 func execCallback(callback interface{}, args ...interface{}) {
@@ -473,6 +570,13 @@ func execCallback(callback interface{}, args ...interface{}) {
 		switch cb := callback.(type) {
 		case func():
 			cb()
+		case func(interface{}):
+			// Any argument, including a nil one, is passed through
+			var arg interface{}
+			if len(args) > 0 {
+				arg = args[0]
+			}
+			cb(arg)
 		case func(int):
 			if len(args) > 0 {
 				if i, ok := args[0].(int); ok {
@@ -487,6 +591,40 @@ func execCallback(callback interface{}, args ...interface{}) {
 			}
 		case func() bool:
 			cb()
+		case func(string):
+			if len(args) > 0 {
+				if str, ok := args[0].(string); ok {
+					cb(str)
+				}
+			}
+		case func(bool):
+			if len(args) > 0 {
+				if b, ok := args[0].(bool); ok {
+					cb(b)
+				}
+			}
+		case func(float64):
+			if len(args) > 0 {
+				if f, ok := args[0].(float64); ok {
+					cb(f)
+				}
+			}
+		case func(float64, float64):
+			if len(args) > 1 {
+				if f1, ok1 := args[0].(float64); ok1 {
+					if f2, ok2 := args[1].(float64); ok2 {
+						cb(f1, f2)
+					}
+				}
+			}
+		case func(string, int):
+			if len(args) > 1 {
+				if str, ok1 := args[0].(string); ok1 {
+					if i, ok2 := args[1].(int); ok2 {
+						cb(str, i)
+					}
+				}
+			}
 		case func(int, int):
 			if len(args) > 1 {
 				if i1, ok1 := args[0].(int); ok1 {
@@ -495,6 +633,16 @@ func execCallback(callback interface{}, args ...interface{}) {
 					}
 				}
 			}
+		case func(int, float64, float64):
+			if len(args) > 2 {
+				if i, ok1 := args[0].(int); ok1 {
+					if x, ok2 := args[1].(float64); ok2 {
+						if y, ok3 := args[2].(float64); ok3 {
+							cb(i, x, y)
+						}
+					}
+				}
+			}
 		// Support for ListItemCallback and its equivalent function type
 		case ListItemCallback:
 			if len(args) > 0 {
@@ -624,8 +772,10 @@ func callbackHandlerWithParam(object *C.GObject, param C.gpointer, data C.gpoint
 			execCallback(callback, paramVal, 0)
 		}
 
-	case callbackData.signal == SignalListActivate && callbackData.source == SourceListView:
-		// For ListView activation - check for multiple possible types
+	case callbackData.signal == SignalListActivate &&
+		(callbackData.source == SourceListView || callbackData.source == SourceColumnView ||
+			callbackData.source == SourceGridView):
+		// For ListView, ColumnView and GridView activation - check for multiple possible types
 		// First try direct function type
 		if callback, ok := callbackData.callback.(func(int)); ok {
 			DebugLog(DebugLevelInfo, DebugComponentListView,
@@ -665,6 +815,9 @@ func callbackHandlerWithParam(object *C.GObject, param C.gpointer, data C.gpoint
 				"Activate callback has wrong type: %T, expected func()", callbackData.callback)
 		}
 
+	case strings.HasPrefix(string(callbackData.signal), "notify::") && dispatchNotifyCallback(object, param, callbackData.callback):
+		// Typed property change callback, called with the new property value
+
 	default:
 		// For other cases, try to call with an int parameter
 		if callback, ok := callbackData.callback.(func(int)); ok {
@@ -672,6 +825,16 @@ func callbackHandlerWithParam(object *C.GObject, param C.gpointer, data C.gpoint
 		} else if callback, ok := callbackData.callback.(func(interface{})); ok {
 			// For callbacks that accept any parameter
 			execCallback(callback, paramVal)
+		} else if callback, ok := callbackData.callback.(func(string)); ok {
+			// For signals with a string argument
+			str := ""
+			if param != nil {
+				str = C.GoString((*C.char)(param))
+			}
+			execCallback(callback, str)
+		} else if callback, ok := callbackData.callback.(func(bool)); ok {
+			// For signals with a gboolean argument
+			execCallback(callback, param != nil)
 		} else if callback, ok := callbackData.callback.(func()); ok {
 			// Try no parameter callback as last resort
 			execCallback(callback)
@@ -682,6 +845,114 @@ func callbackHandlerWithParam(object *C.GObject, param C.gpointer, data C.gpoint
 	}
 }
 
+// isMultiArgCallback returns whether the callback takes arguments that the
+// generic single-parameter handler can't provide
+func isMultiArgCallback(callback interface{}) bool {
+	switch callback.(type) {
+	case func(float64), func(float64, float64), func(string, int):
+		return true
+	}
+	return false
+}
+
+//export closureMarshal
+func closureMarshal(closure *C.GClosure, returnValue *C.GValue, nParamValues C.guint, paramValues *C.GValue, invocationHint C.gpointer, marshalData C.gpointer) {
+	id := uint64(C.closureCallbackId(closure))
+	value, ok := globalCallbackManager.callbacks.Load(id)
+	if !ok {
+		DebugLog(DebugLevelWarning, DebugComponentCallback, "closureMarshal: callback ID %d not found", id)
+		return
+	}
+	callbackData := value.(*callbackData)
+
+	// The first value is the object emitting the signal
+	args := make([]interface{}, 0, int(nParamValues))
+	for i := C.guint(1); i < nParamValues; i++ {
+		args = append(args, ValueFromGValue(C.gvalueAt(paramValues, i)))
+	}
+
+	if !dispatchMultiArgCallback(callbackData.callback, args) {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"closureMarshal: arguments %v of signal %s don't match callback %T", args, callbackData.signal, callbackData.callback)
+	}
+}
+
+// dispatchMultiArgCallback converts the signal arguments to the types the
+// callback takes and calls it. Returns false if the arguments don't match.
+func dispatchMultiArgCallback(callback interface{}, args []interface{}) bool {
+	switch cb := callback.(type) {
+	case func(float64):
+		if len(args) < 1 {
+			return false
+		}
+		if f, ok := toFloat64(args[0]); ok {
+			execCallback(cb, f)
+			return true
+		}
+	case func(float64, float64):
+		if len(args) < 2 {
+			return false
+		}
+		f1, ok1 := toFloat64(args[0])
+		f2, ok2 := toFloat64(args[1])
+		if ok1 && ok2 {
+			execCallback(cb, f1, f2)
+			return true
+		}
+	case func(string, int):
+		if len(args) < 2 {
+			return false
+		}
+		str, ok1 := args[0].(string)
+		i, ok2 := toInt64(args[1])
+		if ok1 && ok2 {
+			execCallback(cb, str, int(i))
+			return true
+		}
+	}
+	return false
+}
+
+// dispatchNotifyCallback calls a typed property change callback with the new
+// value of the property described by the GParamSpec. Returns false if the
+// callback type isn't one of the typed property callbacks.
+func dispatchNotifyCallback(object *C.GObject, pspec C.gpointer, callback interface{}) bool {
+	switch callback.(type) {
+	case func(bool), func(string), func(float64):
+	default:
+		return false
+	}
+
+	name := C.GoString(C.g_param_spec_get_name((*C.GParamSpec)(unsafe.Pointer(pspec))))
+	value, err := getObjectProperty(object, name)
+	if err != nil {
+		DebugLog(DebugLevelError, DebugComponentCallback, "dispatchNotifyCallback: %v", err)
+		return true
+	}
+
+	switch cb := callback.(type) {
+	case func(bool):
+		if b, ok := value.(bool); ok {
+			execCallback(cb, b)
+			return true
+		}
+	case func(string):
+		if str, ok := value.(string); ok {
+			execCallback(cb, str)
+			return true
+		}
+	case func(float64):
+		if f, ok := toFloat64(value); ok {
+			execCallback(cb, f)
+			return true
+		}
+	}
+
+	DebugLog(DebugLevelError, DebugComponentCallback,
+		"dispatchNotifyCallback: property %s has type %T, which doesn't match callback %T", name, value, callback)
+	return true
+}
+
 //export callbackHandlerWithReturn
 func callbackHandlerWithReturn(object *C.GObject, data C.gpointer) C.gboolean {
 	id := uint64(uintptr(data))
@@ -843,8 +1114,10 @@ func StoreDirectCallback(ptr uintptr, signal SignalType, callback interface{}) {
 		"Directly stored callback for pointer %v and signal %s", ptr, signal)
 }
 
-// RunOnUIThread runs a function on the UI thread
-func RunOnUIThread(fn func()) {
-	uithread.RunOnUIThread(fn)
+// RunOnUIThread runs a function on the UI thread. The returned handle can be
+// passed to gtk4go.CancelUIThreadCall to cancel the function before it runs,
+// see gtk4go.RunOnUIThread.
+func RunOnUIThread(fn func()) gtk4go.UIThreadHandle {
+	return gtk4go.RunOnUIThread(fn)
 }
 
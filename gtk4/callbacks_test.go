@@ -0,0 +1,241 @@
+package gtk4
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/justyntemme/gtk4go"
+)
+
+// TestMain runs the main loop on the main thread, where GTK was
+// initialized, while the tests run on other goroutines
+func TestMain(m *testing.M) {
+	var (
+		code int
+		done bool
+	)
+	go func() {
+		result := m.Run()
+		// Set on the UI thread; the idle source also wakes up the main loop
+		gtk4go.RunOnUIThread(func() {
+			code, done = result, true
+		})
+	}()
+
+	for !done {
+		iterateMainLoop(true)
+	}
+	os.Exit(code)
+}
+
+// flushUIThread waits until the functions queued for the UI thread so far,
+// such as the callbacks run by execCallback, have run
+func flushUIThread(t *testing.T) {
+	t.Helper()
+	if err := gtk4go.RunOnUIThreadTimeout(func() {}, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExecCallbackTypes(t *testing.T) {
+	listItem := &ListItem{}
+	tooltip := &Tooltip{}
+
+	tests := []struct {
+		name      string
+		callback  func(record func(...interface{})) interface{}
+		args      []interface{}
+		want      []interface{} // nil if the callback takes no arguments
+		notCalled bool
+	}{
+		{
+			name: "func()",
+			callback: func(record func(...interface{})) interface{} {
+				return func() { record() }
+			},
+		},
+		{
+			name: "func() bool",
+			callback: func(record func(...interface{})) interface{} {
+				return func() bool { record(); return true }
+			},
+		},
+		{
+			name: "func(interface{})",
+			callback: func(record func(...interface{})) interface{} {
+				return func(v interface{}) { record(v) }
+			},
+			args: []interface{}{"value"},
+			want: []interface{}{"value"},
+		},
+		{
+			name: "func(interface{}) without argument",
+			callback: func(record func(...interface{})) interface{} {
+				return func(v interface{}) { record(v) }
+			},
+			want: []interface{}{nil},
+		},
+		{
+			name: "func(int)",
+			callback: func(record func(...interface{})) interface{} {
+				return func(i int) { record(i) }
+			},
+			args: []interface{}{3},
+			want: []interface{}{3},
+		},
+		{
+			name: "func(ResponseType)",
+			callback: func(record func(...interface{})) interface{} {
+				return func(response ResponseType) { record(response) }
+			},
+			args: []interface{}{ResponseOk},
+			want: []interface{}{ResponseOk},
+		},
+		{
+			name: "func(string)",
+			callback: func(record func(...interface{})) interface{} {
+				return func(s string) { record(s) }
+			},
+			args: []interface{}{"text"},
+			want: []interface{}{"text"},
+		},
+		{
+			name: "func(bool)",
+			callback: func(record func(...interface{})) interface{} {
+				return func(b bool) { record(b) }
+			},
+			args: []interface{}{true},
+			want: []interface{}{true},
+		},
+		{
+			name: "func(float64)",
+			callback: func(record func(...interface{})) interface{} {
+				return func(f float64) { record(f) }
+			},
+			args: []interface{}{0.5},
+			want: []interface{}{0.5},
+		},
+		{
+			name: "func(float64, float64)",
+			callback: func(record func(...interface{})) interface{} {
+				return func(x, y float64) { record(x, y) }
+			},
+			args: []interface{}{1.5, 2.5},
+			want: []interface{}{1.5, 2.5},
+		},
+		{
+			name: "func(string, int)",
+			callback: func(record func(...interface{})) interface{} {
+				return func(s string, i int) { record(s, i) }
+			},
+			args: []interface{}{"text", 4},
+			want: []interface{}{"text", 4},
+		},
+		{
+			name: "func(int, int)",
+			callback: func(record func(...interface{})) interface{} {
+				return func(i1, i2 int) { record(i1, i2) }
+			},
+			args: []interface{}{1, 2},
+			want: []interface{}{1, 2},
+		},
+		{
+			name: "func(int, float64, float64)",
+			callback: func(record func(...interface{})) interface{} {
+				return func(n int, x, y float64) { record(n, x, y) }
+			},
+			args: []interface{}{2, 10.0, 20.0},
+			want: []interface{}{2, 10.0, 20.0},
+		},
+		{
+			name: "ListItemCallback",
+			callback: func(record func(...interface{})) interface{} {
+				return ListItemCallback(func(li *ListItem) { record(li) })
+			},
+			args: []interface{}{listItem},
+			want: []interface{}{listItem},
+		},
+		{
+			name: "func(*ListItem)",
+			callback: func(record func(...interface{})) interface{} {
+				return func(li *ListItem) { record(li) }
+			},
+			args: []interface{}{listItem},
+			want: []interface{}{listItem},
+		},
+		{
+			name: "func(int, int, bool, uintptr) bool",
+			callback: func(record func(...interface{})) interface{} {
+				return func(x, y int, keyboard bool, tooltip uintptr) bool {
+					record(x, y, keyboard, tooltip)
+					return true
+				}
+			},
+			args: []interface{}{1, 2, true, uintptr(8)},
+			want: []interface{}{1, 2, true, uintptr(8)},
+		},
+		{
+			name: "func(int, int, bool, *Tooltip) bool",
+			callback: func(record func(...interface{})) interface{} {
+				return func(x, y int, keyboard bool, tooltip *Tooltip) bool {
+					record(x, y, keyboard, tooltip)
+					return true
+				}
+			},
+			args: []interface{}{1, 2, false, tooltip},
+			want: []interface{}{1, 2, false, tooltip},
+		},
+		{
+			name: "func(int) with a string argument",
+			callback: func(record func(...interface{})) interface{} {
+				return func(i int) { record(i) }
+			},
+			args:      []interface{}{"3"},
+			notCalled: true,
+		},
+		{
+			name: "func(float64, float64) with one argument",
+			callback: func(record func(...interface{})) interface{} {
+				return func(x, y float64) { record(x, y) }
+			},
+			args:      []interface{}{1.5},
+			notCalled: true,
+		},
+		{
+			name: "func(*ListItem) with a string argument",
+			callback: func(record func(...interface{})) interface{} {
+				return func(li *ListItem) { record(li) }
+			},
+			args:      []interface{}{"item"},
+			notCalled: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Written on the UI thread, read after flushUIThread
+			var calls [][]interface{}
+			record := func(args ...interface{}) {
+				calls = append(calls, args)
+			}
+
+			execCallback(test.callback(record), test.args...)
+			flushUIThread(t)
+
+			if test.notCalled {
+				if len(calls) != 0 {
+					t.Fatalf("callback called with %v, want no call", calls)
+				}
+				return
+			}
+			if len(calls) != 1 {
+				t.Fatalf("callback called %d times, want 1", len(calls))
+			}
+			if !reflect.DeepEqual(calls[0], test.want) {
+				t.Errorf("callback called with %v, want %v", calls[0], test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Package gtk4 provides center box layout functionality for GTK4
+// File: gtk4go/gtk4/centerBox.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// BaselinePosition defines where the baseline is placed when a container
+// has more vertical space than it needs
+type BaselinePosition int
+
+const (
+	// BaselinePositionTop places the baseline at the top
+	BaselinePositionTop BaselinePosition = C.GTK_BASELINE_POSITION_TOP
+	// BaselinePositionCenter places the baseline in the center
+	BaselinePositionCenter BaselinePosition = C.GTK_BASELINE_POSITION_CENTER
+	// BaselinePositionBottom places the baseline at the bottom
+	BaselinePositionBottom BaselinePosition = C.GTK_BASELINE_POSITION_BOTTOM
+)
+
+// CenterBoxOption is a function that configures a center box
+type CenterBoxOption func(*CenterBox)
+
+// CenterBox represents a GTK center box, which places a widget at its start,
+// one at its end and one centered between them. Each position may be empty;
+// the start and end widgets stay at the edges without a center widget.
+type CenterBox struct {
+	BaseWidget
+}
+
+// NewCenterBox creates a new horizontal GTK center box
+func NewCenterBox(options ...CenterBoxOption) *CenterBox {
+	centerBox := &CenterBox{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_center_box_new(),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(centerBox)
+	}
+
+	SetupFinalization(centerBox, centerBox.Destroy)
+	return centerBox
+}
+
+// WithCenterBoxOrientation sets the orientation of the center box
+func WithCenterBoxOrientation(orientation Orientation) CenterBoxOption {
+	return func(cb *CenterBox) {
+		cb.SetOrientation(orientation)
+	}
+}
+
+// WithStartWidget sets the widget placed at the start
+func WithStartWidget(widget Widget) CenterBoxOption {
+	return func(cb *CenterBox) {
+		cb.SetStartWidget(widget)
+	}
+}
+
+// WithCenterWidget sets the widget placed in the center
+func WithCenterWidget(widget Widget) CenterBoxOption {
+	return func(cb *CenterBox) {
+		cb.SetCenterWidget(widget)
+	}
+}
+
+// WithEndWidget sets the widget placed at the end
+func WithEndWidget(widget Widget) CenterBoxOption {
+	return func(cb *CenterBox) {
+		cb.SetEndWidget(widget)
+	}
+}
+
+// centerBox returns the widget as a GtkCenterBox
+func (cb *CenterBox) centerBox() *C.GtkCenterBox {
+	return (*C.GtkCenterBox)(unsafe.Pointer(cb.widget))
+}
+
+// widgetOrNil returns the GtkWidget of a widget, or nil for a nil widget
+func widgetOrNil(widget Widget) *C.GtkWidget {
+	if widget == nil {
+		return nil
+	}
+	return widget.GetWidget()
+}
+
+// SetStartWidget sets the widget placed at the start; nil removes it
+func (cb *CenterBox) SetStartWidget(widget Widget) {
+	C.gtk_center_box_set_start_widget(cb.centerBox(), widgetOrNil(widget))
+}
+
+// SetCenterWidget sets the widget placed in the center; nil removes it
+func (cb *CenterBox) SetCenterWidget(widget Widget) {
+	C.gtk_center_box_set_center_widget(cb.centerBox(), widgetOrNil(widget))
+}
+
+// SetEndWidget sets the widget placed at the end; nil removes it
+func (cb *CenterBox) SetEndWidget(widget Widget) {
+	C.gtk_center_box_set_end_widget(cb.centerBox(), widgetOrNil(widget))
+}
+
+// GetStartWidget returns the widget placed at the start, or nil
+func (cb *CenterBox) GetStartWidget() Widget {
+	return wrapChildWidget(C.gtk_center_box_get_start_widget(cb.centerBox()))
+}
+
+// GetCenterWidget returns the widget placed in the center, or nil
+func (cb *CenterBox) GetCenterWidget() Widget {
+	return wrapChildWidget(C.gtk_center_box_get_center_widget(cb.centerBox()))
+}
+
+// GetEndWidget returns the widget placed at the end, or nil
+func (cb *CenterBox) GetEndWidget() Widget {
+	return wrapChildWidget(C.gtk_center_box_get_end_widget(cb.centerBox()))
+}
+
+// wrapChildWidget returns a basic wrapper for a child widget, or nil. The
+// wrapper stops referring to the child once the child is finalized.
+func wrapChildWidget(widget *C.GtkWidget) Widget {
+	if widget == nil {
+		return nil
+	}
+	wrapper := &BaseWidget{widget: widget}
+	trackWrapper(wrapper)
+	return wrapper
+}
+
+// SetOrientation sets the orientation of the center box
+func (cb *CenterBox) SetOrientation(orientation Orientation) {
+	C.gtk_orientable_set_orientation((*C.GtkOrientable)(unsafe.Pointer(cb.widget)), C.GtkOrientation(orientation))
+}
+
+// SetBaselinePosition sets where the baseline is placed when the center box
+// is taller than its children need
+func (cb *CenterBox) SetBaselinePosition(position BaselinePosition) {
+	C.gtk_center_box_set_baseline_position(cb.centerBox(), C.GtkBaselinePosition(position))
+}
+
+// GetBaselinePosition returns where the baseline is placed
+func (cb *CenterBox) GetBaselinePosition() BaselinePosition {
+	return BaselinePosition(C.gtk_center_box_get_baseline_position(cb.centerBox()))
+}
+
+// Destroy destroys the center box and cleans up resources
+func (cb *CenterBox) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(cb)
+
+	// Call base destroy method
+	cb.BaseWidget.Destroy()
+}
@@ -0,0 +1,298 @@
+// Package gtk4 provides column view functionality for GTK4
+// File: gtk4go/gtk4/columnView.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// // Create a column; the factory is set separately because
+// // gtk_column_view_column_new takes ownership of it
+// static GtkColumnViewColumn* createColumnViewColumn(const char *title, GtkListItemFactory *factory) {
+//     GtkColumnViewColumn *column = gtk_column_view_column_new(title, NULL);
+//     gtk_column_view_column_set_factory(column, factory);
+//     return column;
+// }
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// ColumnViewColumn represents a column of a ColumnView. Its factory creates
+// the cells of the column, like the factory of a ListView creates its rows.
+type ColumnViewColumn struct {
+	column  *C.GtkColumnViewColumn
+	factory ListItemFactory
+}
+
+// NewColumnViewColumn creates a new column with the given title and factory
+func NewColumnViewColumn(title string, factory ListItemFactory) *ColumnViewColumn {
+	cTitle := C.CString(title)
+	defer C.free(unsafe.Pointer(cTitle))
+
+	var cFactory *C.GtkListItemFactory
+	if factory != nil {
+		cFactory = factory.GetListItemFactory()
+	}
+
+	column := &ColumnViewColumn{
+		column:  C.createColumnViewColumn(cTitle, cFactory),
+		factory: factory,
+	}
+
+	runtime.SetFinalizer(column, (*ColumnViewColumn).Destroy)
+	return column
+}
+
+// NewBoundColumn creates a column whose cells are labels showing the text
+// that get returns for the row's item (as returned by ListItem.GetItem), so
+// that each column can show a different field of the same row. Recycled
+// cells are re-bound and cleared on unbind, and rows without an item are
+// shown empty without calling get.
+func NewBoundColumn(title string, get func(item interface{}) string) *ColumnViewColumn {
+	factory := NewSignalListItemFactory()
+	factory.BindLabel(func(item interface{}) string {
+		if item == nil || get == nil {
+			return ""
+		}
+		return get(item)
+	})
+
+	return NewColumnViewColumn(title, factory)
+}
+
+// Native returns the underlying GtkColumnViewColumn pointer as uintptr
+func (c *ColumnViewColumn) Native() uintptr {
+	return uintptr(unsafe.Pointer(c.column))
+}
+
+// SetTitle sets the title shown in the column header
+func (c *ColumnViewColumn) SetTitle(title string) {
+	cTitle := C.CString(title)
+	defer C.free(unsafe.Pointer(cTitle))
+	C.gtk_column_view_column_set_title(c.column, cTitle)
+}
+
+// GetFactory returns the factory that creates the cells of the column
+func (c *ColumnViewColumn) GetFactory() ListItemFactory {
+	return c.factory
+}
+
+// SetExpand sets whether the column takes up extra horizontal space
+func (c *ColumnViewColumn) SetExpand(expand bool) {
+	var cexpand C.gboolean
+	if expand {
+		cexpand = C.TRUE
+	} else {
+		cexpand = C.FALSE
+	}
+	C.gtk_column_view_column_set_expand(c.column, cexpand)
+}
+
+// SetResizable sets whether the user can resize the column
+func (c *ColumnViewColumn) SetResizable(resizable bool) {
+	var cresizable C.gboolean
+	if resizable {
+		cresizable = C.TRUE
+	} else {
+		cresizable = C.FALSE
+	}
+	C.gtk_column_view_column_set_resizable(c.column, cresizable)
+}
+
+// SetFixedWidth sets the width of the column; -1 sizes it to its contents
+func (c *ColumnViewColumn) SetFixedWidth(width int) {
+	C.gtk_column_view_column_set_fixed_width(c.column, C.int(width))
+}
+
+// SetVisible sets whether the column is shown
+func (c *ColumnViewColumn) SetVisible(visible bool) {
+	var cvisible C.gboolean
+	if visible {
+		cvisible = C.TRUE
+	} else {
+		cvisible = C.FALSE
+	}
+	C.gtk_column_view_column_set_visible(c.column, cvisible)
+}
+
+// Destroy releases the column. A column that was added to a column view
+// stays there until it is removed.
+func (c *ColumnViewColumn) Destroy() {
+	if c.column != nil {
+		C.g_object_unref(C.gpointer(unsafe.Pointer(c.column)))
+		c.column = nil
+	}
+}
+
+// ColumnViewOption is a function that configures a column view
+type ColumnViewOption func(*ColumnView)
+
+// ColumnView represents a GTK column view, which shows the items of a model
+// in rows, with one cell per column
+type ColumnView struct {
+	BaseWidget
+	selectionModel SelectionModel
+	columns        []*ColumnViewColumn
+}
+
+// NewColumnView creates a new GTK column view. Add columns with AppendColumn.
+func NewColumnView(selectionModel SelectionModel, options ...ColumnViewOption) *ColumnView {
+	var model *C.GtkSelectionModel
+	if selectionModel != nil {
+		// gtk_column_view_new takes ownership of the model
+		model = selectionModel.GetSelectionModel()
+		C.g_object_ref(C.gpointer(unsafe.Pointer(model)))
+	}
+
+	columnView := &ColumnView{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_column_view_new(model),
+		},
+		selectionModel: selectionModel,
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(columnView)
+	}
+
+	SetupFinalization(columnView, columnView.Destroy)
+	return columnView
+}
+
+// WithShowRowSeparators sets whether separators are shown between rows
+func WithShowRowSeparators(show bool) ColumnViewOption {
+	return func(cv *ColumnView) {
+		cv.SetShowRowSeparators(show)
+	}
+}
+
+// WithShowColumnSeparators sets whether separators are shown between columns
+func WithShowColumnSeparators(show bool) ColumnViewOption {
+	return func(cv *ColumnView) {
+		cv.SetShowColumnSeparators(show)
+	}
+}
+
+// columnView returns the widget as a GtkColumnView
+func (cv *ColumnView) columnView() *C.GtkColumnView {
+	return (*C.GtkColumnView)(unsafe.Pointer(cv.widget))
+}
+
+// SetModel sets the selection model for the column view
+func (cv *ColumnView) SetModel(model SelectionModel) {
+	if model != nil {
+		C.gtk_column_view_set_model(cv.columnView(), model.GetSelectionModel())
+	} else {
+		C.gtk_column_view_set_model(cv.columnView(), nil)
+	}
+	cv.selectionModel = model
+}
+
+// GetModel returns the selection model for the column view
+func (cv *ColumnView) GetModel() SelectionModel {
+	return cv.selectionModel
+}
+
+// AppendColumn adds a column at the end of the column view
+func (cv *ColumnView) AppendColumn(column *ColumnViewColumn) {
+	C.gtk_column_view_append_column(cv.columnView(), column.column)
+	cv.columns = append(cv.columns, column)
+}
+
+// RemoveColumn removes a column from the column view
+func (cv *ColumnView) RemoveColumn(column *ColumnViewColumn) {
+	for i, c := range cv.columns {
+		if c == column {
+			C.gtk_column_view_remove_column(cv.columnView(), column.column)
+			cv.columns = append(cv.columns[:i], cv.columns[i+1:]...)
+			return
+		}
+	}
+}
+
+// GetColumns returns the columns of the column view
+func (cv *ColumnView) GetColumns() []*ColumnViewColumn {
+	return append([]*ColumnViewColumn(nil), cv.columns...)
+}
+
+// SetShowRowSeparators sets whether separators are shown between rows
+func (cv *ColumnView) SetShowRowSeparators(show bool) {
+	var cshow C.gboolean
+	if show {
+		cshow = C.TRUE
+	} else {
+		cshow = C.FALSE
+	}
+	C.gtk_column_view_set_show_row_separators(cv.columnView(), cshow)
+}
+
+// SetShowColumnSeparators sets whether separators are shown between columns
+func (cv *ColumnView) SetShowColumnSeparators(show bool) {
+	var cshow C.gboolean
+	if show {
+		cshow = C.TRUE
+	} else {
+		cshow = C.FALSE
+	}
+	C.gtk_column_view_set_show_column_separators(cv.columnView(), cshow)
+}
+
+// SetSingleClickActivate sets whether rows are activated on single click
+func (cv *ColumnView) SetSingleClickActivate(singleClickActivate bool) {
+	var csingleClickActivate C.gboolean
+	if singleClickActivate {
+		csingleClickActivate = C.TRUE
+	} else {
+		csingleClickActivate = C.FALSE
+	}
+	C.gtk_column_view_set_single_click_activate(cv.columnView(), csingleClickActivate)
+}
+
+// SetReorderable sets whether the user can reorder columns by dragging
+// their headers
+func (cv *ColumnView) SetReorderable(reorderable bool) {
+	var creorderable C.gboolean
+	if reorderable {
+		creorderable = C.TRUE
+	} else {
+		creorderable = C.FALSE
+	}
+	C.gtk_column_view_set_reorderable(cv.columnView(), creorderable)
+}
+
+// ConnectActivate connects a callback for row activation. The callback
+// receives the position of the activated row. The returned ID can be used
+// with Disconnect; all callbacks are disconnected when the column view is
+// destroyed.
+func (cv *ColumnView) ConnectActivate(callback func(position int)) uint64 {
+	if callback == nil {
+		return 0
+	}
+
+	// Connect using the unified callback system, which delivers the
+	// position as an int for list activation signals
+	return Connect(cv, SignalListActivate, callback)
+}
+
+// DisconnectActivate disconnects all activate signal handlers
+func (cv *ColumnView) DisconnectActivate() {
+	viewPtr := uintptr(unsafe.Pointer(cv.widget))
+	for _, id := range getCallbackIDsForSignal(viewPtr, SignalListActivate) {
+		Disconnect(id)
+	}
+}
+
+// Destroy destroys the column view and cleans up resources
+func (cv *ColumnView) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(cv)
+
+	cv.columns = nil
+
+	// Call base destroy method
+	cv.BaseWidget.Destroy()
+}
@@ -11,6 +11,29 @@ package gtk4
 //     gtk_css_provider_load_from_string(provider, css_string);
 // }
 //
+// // Collect parsing errors as "line L, column C: message" entries separated by newlines
+// static void _go_css_parsing_error(GtkCssProvider *provider, GtkCssSection *section, const GError *error, gpointer user_data) {
+//     GString *errors = user_data;
+//     const GtkCssLocation *start = gtk_css_section_get_start_location(section);
+//     if (errors->len > 0) {
+//         g_string_append_c(errors, '\n');
+//     }
+//     g_string_append_printf(errors, "line %zu, column %zu: %s", start->lines + 1, start->line_chars + 1, error->message);
+// }
+//
+// // Load CSS and return the parsing errors, or NULL if there were none; free with g_free
+// static char* _go_css_provider_load_collecting_errors(GtkCssProvider *provider, const char *css_string) {
+//     GString *errors = g_string_new(NULL);
+//     gulong handler = g_signal_connect(provider, "parsing-error", G_CALLBACK(_go_css_parsing_error), errors);
+//     gtk_css_provider_load_from_string(provider, css_string);
+//     g_signal_handler_disconnect(provider, handler);
+//     if (errors->len == 0) {
+//         g_string_free(errors, TRUE);
+//         return NULL;
+//     }
+//     return g_string_free(errors, FALSE);
+// }
+//
 // // Helper to mark CSS provider for optimization
 // static void _go_css_provider_set_optimization(GtkCssProvider *provider, gboolean optimize) {
 //     g_object_set_data(G_OBJECT(provider), "optimize-rendering", GINT_TO_POINTER(optimize ? 1 : 0));
@@ -18,10 +41,14 @@ package gtk4
 import "C"
 
 import (
+	"errors"
 	"os"
 	"runtime"
+	"strings"
 	"sync"
+	"time"
 	"unsafe"
+	"weak"
 )
 
 // Global CSS provider cache to avoid recreating providers
@@ -34,6 +61,9 @@ var (
 	globalProviders     = make([]*CSSProvider, 0, 5)
 	globalProviderMutex sync.RWMutex
 
+	// Priorities of the providers added to the default display, guarded by globalProviderMutex
+	displayProviderPriorities = make(map[*CSSProvider]uint)
+
 	// Lightweight CSS for resize operations
 	resizeCSSProvider *CSSProvider
 )
@@ -70,7 +100,9 @@ func newCSSProvider() *CSSProvider {
 	return provider
 }
 
-// loadFromData loads CSS data from a string
+// loadFromData loads CSS data from a string, replacing the provider's
+// previous rules. Rules that fail to parse are skipped; their errors are
+// returned together, with line and column numbers.
 func (p *CSSProvider) loadFromData(cssData string) error {
 	// Store the CSS data for cache lookups
 	p.cssData = cssData
@@ -78,8 +110,39 @@ func (p *CSSProvider) loadFromData(cssData string) error {
 	cCssData := C.CString(cssData)
 	defer C.free(unsafe.Pointer(cCssData))
 
-	// Use the helper function that calls the GTK4 API
-	C._go_css_provider_load_from_string(p.provider, cCssData)
+	// Load through the helper that collects parsing errors instead of
+	// leaving them to GTK's warnings
+	cErrors := C._go_css_provider_load_collecting_errors(p.provider, cCssData)
+	if cErrors == nil {
+		return nil
+	}
+	defer C.g_free(C.gpointer(unsafe.Pointer(cErrors)))
+
+	return &GTKError{Op: "LoadCSS", Err: errors.New(strings.ReplaceAll(C.GoString(cErrors), "\n", "; "))}
+}
+
+// NewCSSProvider creates a new, empty CSS provider. Load CSS into it with
+// LoadFromData.
+func NewCSSProvider() *CSSProvider {
+	return newCSSProvider()
+}
+
+// LoadFromData loads CSS from a string, replacing the provider's previous
+// rules. Rules that fail to parse are skipped and the others still apply;
+// all parsing errors are returned as one error, each with its line and
+// column, e.g. "line 3, column 5: ...; line 9, column 1: ...".
+func (p *CSSProvider) LoadFromData(cssData string) error {
+	// The provider's contents change, so it must no longer be shared
+	// through the cache
+	p.uncache()
+
+	if err := p.loadFromData(cssData); err != nil {
+		var gtkErr *GTKError
+		if errors.As(err, &gtkErr) {
+			gtkErr.Op = "CSSProvider.LoadFromData"
+		}
+		return err
+	}
 	return nil
 }
 
@@ -97,28 +160,28 @@ func (p *CSSProvider) free() {
 	if p.provider != nil {
 		// Remove from global providers list if present
 		globalProviderMutex.Lock()
-		for i, provider := range globalProviders {
-			if provider == p {
-				// Remove without preserving order
-				globalProviders[i] = globalProviders[len(globalProviders)-1]
-				globalProviders = globalProviders[:len(globalProviders)-1]
-				break
-			}
-		}
+		removeGlobalProvider(p)
 		globalProviderMutex.Unlock()
 
 		// Remove from cache if present
-		if p.cssData != "" {
-			cssProviderMutex.Lock()
-			delete(cssProviderCache, p.cssData)
-			cssProviderMutex.Unlock()
-		}
+		p.uncache()
 
 		C.g_object_unref(C.gpointer(unsafe.Pointer(p.provider)))
 		p.provider = nil
 	}
 }
 
+// uncache removes the provider from the provider cache, so that its
+// contents can change without affecting other users of the same CSS
+func (p *CSSProvider) uncache() {
+	cssProviderMutex.Lock()
+	defer cssProviderMutex.Unlock()
+
+	if cssProviderCache[p.cssData] == p {
+		delete(cssProviderCache, p.cssData)
+	}
+}
+
 // setOptimization enables or disables rendering optimization for this provider
 func (p *CSSProvider) setOptimization(optimize bool) {
 	var cOptimize C.gboolean
@@ -188,17 +251,93 @@ func restoreOriginalCSSProvider(display *C.GdkDisplay, original *C.GtkCssProvide
 	// we just temporarily added a higher-priority provider
 }
 
-// AddProviderForDisplay adds a CSS provider to the default display
+// removeGlobalProvider removes a provider from the global providers list.
+// The caller must hold globalProviderMutex.
+func removeGlobalProvider(p *CSSProvider) {
+	for i, provider := range globalProviders {
+		if provider == p {
+			// Remove without preserving order
+			globalProviders[i] = globalProviders[len(globalProviders)-1]
+			globalProviders = globalProviders[:len(globalProviders)-1]
+			break
+		}
+	}
+	delete(displayProviderPriorities, p)
+}
+
+// AddProviderForDisplay adds a CSS provider to the default display with a
+// priority such as StyleProviderPriorityApplication. Adding a provider that was already added changes its priority.
 func AddProviderForDisplay(provider *CSSProvider, priority uint) {
 	display := C.gdk_display_get_default()
-	C.gtk_style_context_add_provider_for_display(display,
-		(*C.GtkStyleProvider)(unsafe.Pointer(provider.provider)),
-		C.guint(priority))
+	styleProvider := (*C.GtkStyleProvider)(unsafe.Pointer(provider.provider))
 
-	// Add to global providers list for optimization
 	globalProviderMutex.Lock()
+	defer globalProviderMutex.Unlock()
+
+	// Avoid stacking the same provider twice
+	if _, added := displayProviderPriorities[provider]; added {
+		C.gtk_style_context_remove_provider_for_display(display, styleProvider)
+		removeGlobalProvider(provider)
+	}
+
+	C.gtk_style_context_add_provider_for_display(display, styleProvider, C.guint(priority))
+
+	// Add to global providers list for optimization
 	globalProviders = append(globalProviders, provider)
-	globalProviderMutex.Unlock()
+	displayProviderPriorities[provider] = priority
+}
+
+// RemoveProviderForDisplay removes a CSS provider from the default display.
+// Removing a provider that was never added is a no-op.
+func RemoveProviderForDisplay(provider *CSSProvider) {
+	if provider == nil {
+		return
+	}
+
+	globalProviderMutex.Lock()
+	defer globalProviderMutex.Unlock()
+
+	if _, added := displayProviderPriorities[provider]; !added {
+		return
+	}
+
+	if provider.provider != nil {
+		C.gtk_style_context_remove_provider_for_display(C.gdk_display_get_default(),
+			(*C.GtkStyleProvider)(unsafe.Pointer(provider.provider)))
+	}
+	removeGlobalProvider(provider)
+}
+
+// ReplaceProviderForDisplay replaces a CSS provider of the default display
+// with another, e.g. to switch themes. The new provider is added before the
+// old one is removed, so the display is never left without styles. A priority
+// of 0 keeps the priority the old provider was added with; if oldProvider was never
+// added, 0 means the application priority.
+func ReplaceProviderForDisplay(oldProvider, newProvider *CSSProvider, priority uint) {
+	if priority == 0 {
+		priority = uint(StyleProviderPriorityApplication)
+
+		globalProviderMutex.RLock()
+		if oldPriority, added := displayProviderPriorities[oldProvider]; added {
+			priority = oldPriority
+		}
+		globalProviderMutex.RUnlock()
+	}
+
+	AddProviderForDisplay(newProvider, priority)
+	if oldProvider != newProvider {
+		RemoveProviderForDisplay(oldProvider)
+	}
+}
+
+// GetProviderPriority returns the priority a provider was added to the
+// default display with, and whether it is currently added
+func GetProviderPriority(provider *CSSProvider) (uint, bool) {
+	globalProviderMutex.RLock()
+	defer globalProviderMutex.RUnlock()
+
+	priority, added := displayProviderPriorities[provider]
+	return priority, added
 }
 
 // Widget CSS class methods - using modern GTK4 API
@@ -231,24 +370,37 @@ func HasStyleClass(widget interface{}, className string) bool {
 	return false
 }
 
-// StylePriority defines the priority levels for CSS providers
+// StylePriority defines the priority levels for CSS providers. Rules of a
+// provider with a higher priority override those of lower priorities.
 type StylePriority uint
 
 const (
-	// priorityApplication is the priority for application-specific styles
-	priorityApplication StylePriority = 600
-	// priorityUser is the priority for user-specific styles
-	priorityUser StylePriority = 800
-	// priorityTheme is the priority for theme styles
-	priorityTheme StylePriority = 400
-	// prioritySetting is the priority for settings styles
-	prioritySetting StylePriority = 500
-	// priorityFallback is the priority for fallback styles
-	priorityFallback StylePriority = 1
+	// StyleProviderPriorityFallback is the priority for fallback styles, used
+	// when there is no theme
+	StyleProviderPriorityFallback StylePriority = C.GTK_STYLE_PROVIDER_PRIORITY_FALLBACK
+	// StyleProviderPriorityTheme is the priority of the theme
+	StyleProviderPriorityTheme StylePriority = C.GTK_STYLE_PROVIDER_PRIORITY_THEME
+	// StyleProviderPrioritySettings is the priority for styles derived from
+	// the GTK settings, such as the font
+	StyleProviderPrioritySettings StylePriority = C.GTK_STYLE_PROVIDER_PRIORITY_SETTINGS
+	// StyleProviderPriorityApplication is the priority for application styles,
+	// which override the theme
+	StyleProviderPriorityApplication StylePriority = C.GTK_STYLE_PROVIDER_PRIORITY_APPLICATION
+	// StyleProviderPriorityUser is the priority of the user's own CSS
+	// (~/.config/gtk-4.0/gtk.css), which overrides application styles
+	StyleProviderPriorityUser StylePriority = C.GTK_STYLE_PROVIDER_PRIORITY_USER
+
 	// priorityResize is a higher priority used during resize operations
 	priorityResize StylePriority = 900
 )
 
+// AddProviderForDisplayWithPriority adds a CSS provider to the default
+// display with one of the StyleProviderPriority constants, e.g.
+// StyleProviderPriorityApplication
+func AddProviderForDisplayWithPriority(provider *CSSProvider, priority StylePriority) {
+	AddProviderForDisplay(provider, uint(priority))
+}
+
 // loadCSS is a convenience function to create a provider and load CSS from a string with caching
 func loadCSS(cssData string) (*CSSProvider, error) {
 	// Check cache first
@@ -260,11 +412,12 @@ func loadCSS(cssData string) (*CSSProvider, error) {
 		return provider, nil
 	}
 
-	// Create new provider if not in cache
+	// Create new provider if not in cache. A provider with parsing errors
+	// is returned with the error but not cached, so the error isn't lost
 	provider = newCSSProvider()
 	err := provider.loadFromData(cssData)
 	if err != nil {
-		return nil, err
+		return provider, err
 	}
 
 	// Store in cache
@@ -275,16 +428,96 @@ func loadCSS(cssData string) (*CSSProvider, error) {
 	return provider, nil
 }
 
-// LoadCSS is a public convenience function to create a provider and load CSS from a string
+// LoadCSS is a public convenience function to create a provider and load CSS from a string.
+// If the CSS has parsing errors, the provider is returned with the rules that
+// did parse, together with an error listing the line and column of each problem.
 func LoadCSS(cssData string) (*CSSProvider, error) {
 	return loadCSS(cssData)
 }
 
-// LoadCSSFromFile is a convenience function to create a provider and load CSS from a file
+// LoadCSSFromFile is a convenience function to create a provider and load CSS from a file.
+// Parsing errors are reported like LoadCSS. The provider isn't shared with
+// other providers loaded from the same CSS, so it can be reloaded with WatchCSSFile.
 func LoadCSSFromFile(filepath string) (*CSSProvider, error) {
 	data, err := os.ReadFile(filepath)
 	if err != nil {
 		return nil, err
 	}
-	return loadCSS(string(data))
+
+	provider := newCSSProvider()
+	return provider, provider.loadFromData(string(data))
+}
+
+// cssWatchInterval is how often WatchCSSFile checks the file for changes
+const cssWatchInterval = 500 * time.Millisecond
+
+// WatchCSSFile reloads a provider from a CSS file whenever the file changes,
+// which allows tweaking styles of a running application during development.
+// The file is polled in a goroutine and reloads happen on the UI thread,
+// after which onReload (if not nil) is called on the UI thread with nil or
+// the parsing error. Watching stops when the returned function is called or
+// the provider is freed.
+func WatchCSSFile(filepath string, provider *CSSProvider, onReload func(err error)) (stop func()) {
+	done := make(chan struct{})
+	var stopOnce sync.Once
+	stop = func() {
+		stopOnce.Do(func() { close(done) })
+	}
+
+	// The provider's contents will change, so it must not be shared
+	provider.uncache()
+
+	lastModTime := time.Time{}
+	if info, err := os.Stat(filepath); err == nil {
+		lastModTime = info.ModTime()
+	}
+
+	// Only hold a weak pointer, so that the watcher doesn't keep the provider alive
+	weakProvider := weak.Make(provider)
+
+	go func() {
+		ticker := time.NewTicker(cssWatchInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			if p := weakProvider.Value(); p == nil || p.provider == nil {
+				return
+			}
+
+			info, err := os.Stat(filepath)
+			if err != nil || info.ModTime().Equal(lastModTime) {
+				// A missing file is usually being replaced by an editor
+				continue
+			}
+			lastModTime = info.ModTime()
+
+			data, err := os.ReadFile(filepath)
+			if err != nil {
+				continue
+			}
+
+			RunOnUIThread(func() {
+				p := weakProvider.Value()
+				if p == nil || p.provider == nil {
+					return
+				}
+
+				err := p.loadFromData(string(data))
+				if err != nil {
+					DebugLog(DebugLevelWarning, DebugComponentGeneral, "WatchCSSFile: %s: %v", filepath, err)
+				}
+				if onReload != nil {
+					onReload(err)
+				}
+			})
+		}
+	}()
+
+	return stop
 }
@@ -4,10 +4,26 @@ package gtk4
 
 import (
 	"fmt"
+	"io"
 	"log"
 	"sync"
+
+	"github.com/justyntemme/gtk4go/core/uithread"
 )
 
+// DebugLevel is the severity of a debug message; see the DebugLevel constants
+type DebugLevel = int
+
+// DebugComponent identifies the part of the library a debug message comes
+// from; see the DebugComponent constants
+type DebugComponent = string
+
+// DebugHandler receives debug messages that pass the level and component
+// filters. It may be called concurrently from any goroutine, including the
+// UI thread inside GTK callbacks, so it must be safe for concurrent use and
+// must not block.
+type DebugHandler func(level DebugLevel, component DebugComponent, msg string)
+
 // Debug logging levels
 const (
 	DebugLevelNone    = 0 // No debug output
@@ -27,18 +43,33 @@ const (
 	DebugComponentTooltip     = "tooltip"
 	DebugComponentAction      = "action"
 	DebugComponentSelection   = "selection"
+	DebugComponentModel       = "model"
 )
 
 // Global debug configuration
 var (
-	debugLevel     = DebugLevelNone
+	debugLevel     = DebugLevelError
 	debugFilter    = make(map[string]bool)
 	debugLogPrefix = "[GTK4Go] "
 	debugMutex     sync.RWMutex
 	debugToStdErr  = false
+	debugOutput    io.Writer
+	debugHandler   DebugHandler
+
+	// Serializes writes to debugOutput, which need not be safe for concurrent use
+	debugOutputMutex sync.Mutex
 )
 
-// SetDebugLevel sets the global debug level
+func init() {
+	// Report panics recovered on the UI thread through the debug system
+	uithread.SetPanicHandler(func(value interface{}, stack []byte) {
+		DebugLog(DebugLevelError, DebugComponentGeneral, "recovered panic on the UI thread: %v\n%s", value, stack)
+	})
+}
+
+// SetDebugLevel sets the global debug level. The default is
+// DebugLevelError, so errors are logged unless the level is set to
+// DebugLevelNone.
 func SetDebugLevel(level int) {
 	debugMutex.Lock()
 	defer debugMutex.Unlock()
@@ -78,6 +109,7 @@ func EnableAllDebugComponents() {
 	debugFilter[DebugComponentListFactory] = true
 	debugFilter[DebugComponentAction] = true
 	debugFilter[DebugComponentSelection] = true
+	debugFilter[DebugComponentModel] = true
 }
 
 // SetDebugToStdErr sets whether debug output should go to stderr
@@ -87,6 +119,25 @@ func SetDebugToStdErr(useStdErr bool) {
 	debugToStdErr = useStdErr
 }
 
+// SetDebugOutput sets the writer that debug output is written to, one line
+// per message. nil restores the default of stdout (or the log package, see
+// SetDebugToStdErr). The output isn't used while a handler is set.
+func SetDebugOutput(w io.Writer) {
+	debugMutex.Lock()
+	defer debugMutex.Unlock()
+	debugOutput = w
+}
+
+// SetDebugHandler sets a handler that receives debug messages instead of the
+// output, e.g. to route them into an application's logger. Messages are
+// filtered by level and component before the handler is called. nil removes
+// the handler.
+func SetDebugHandler(handler DebugHandler) {
+	debugMutex.Lock()
+	defer debugMutex.Unlock()
+	debugHandler = handler
+}
+
 // DebugLog logs a debug message if the current level is high enough
 // and the component is enabled for debugging
 func DebugLog(level int, component string, format string, args ...interface{}) {
@@ -94,14 +145,26 @@ func DebugLog(level int, component string, format string, args ...interface{}) {
 	currentLevel := debugLevel
 	isComponentEnabled, exists := debugFilter[component]
 	useStdErr := debugToStdErr
+	output := debugOutput
+	handler := debugHandler
 	debugMutex.RUnlock()
 
 	// Only log if the level is appropriate and component is enabled (or not explicitly disabled)
 	if level <= currentLevel && (isComponentEnabled || !exists) {
 		message := fmt.Sprintf(format, args...)
+
+		if handler != nil {
+			callDebugHandler(handler, level, component, message)
+			return
+		}
+
 		logMessage := fmt.Sprintf("%s[%s] %s", debugLogPrefix, component, message)
 
-		if useStdErr {
+		if output != nil {
+			debugOutputMutex.Lock()
+			fmt.Fprintf(output, "%s\n", logMessage)
+			debugOutputMutex.Unlock()
+		} else if useStdErr {
 			log.Printf("%s\n", logMessage)
 		} else {
 			fmt.Printf("%s\n", logMessage)
@@ -109,3 +172,14 @@ func DebugLog(level int, component string, format string, args ...interface{}) {
 	}
 }
 
+// callDebugHandler calls a debug handler, recovering from panics so that a
+// faulty handler can't unwind through a GTK callback
+func callDebugHandler(handler DebugHandler, level int, component string, message string) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("%s[%s] debug handler panicked: %v\n", debugLogPrefix, DebugComponentGeneral, r)
+		}
+	}()
+	handler(level, component, message)
+}
+
@@ -26,6 +26,7 @@ package gtk4
 import "C"
 
 import (
+	"fmt"
 	"unsafe"
 )
 
@@ -262,6 +263,31 @@ func (d *Dialog) DisconnectResponse() {
 	})
 }
 
+// Run presents the dialog and returns immediately. When the user clicks a
+// button or closes the window, the callback (if not nil) is called with the
+// response, ResponseDeleteEvent for the window's close button, and the
+// dialog is then destroyed. The dialog is destroyed exactly once, so it must
+// not be destroyed by the callback or used after it.
+func (d *Dialog) Run(callback func(responseId ResponseType)) {
+	// Only hide the window on close so that it is destroyed here, not by GTK
+	C.gtk_window_set_hide_on_close((*C.GtkWindow)(unsafe.Pointer(d.widget)), C.TRUE)
+
+	finished := false
+	d.ConnectResponse(func(responseId ResponseType) {
+		if finished {
+			return
+		}
+		finished = true
+
+		if callback != nil {
+			callback(responseId)
+		}
+		d.Destroy()
+	})
+
+	d.Present()
+}
+
 // Destroy overrides Window's Destroy to clean up dialog resources
 func (d *Dialog) Destroy() {
 	DebugLog(DebugLevelInfo, DebugComponentDialog, "Destroying dialog %v", 
@@ -293,11 +319,109 @@ const (
 // MessageDialog represents a GTK message dialog
 type MessageDialog struct {
 	Dialog
-	messageType MessageType
+	messageType    MessageType
+	secondaryLabel *Label // nil until secondary text is set
 }
 
+// ButtonsType defines the set of buttons of a message dialog. The values
+// match GtkButtonsType.
+type ButtonsType int
+
+const (
+	// ButtonsNone adds no buttons; add them with AddButton
+	ButtonsNone ButtonsType = iota
+	// ButtonsOk adds an OK button
+	ButtonsOk
+	// ButtonsClose adds a Close button
+	ButtonsClose
+	// ButtonsCancel adds a Cancel button
+	ButtonsCancel
+	// ButtonsYesNo adds No and Yes buttons
+	ButtonsYesNo
+	// ButtonsOkCancel adds Cancel and OK buttons
+	ButtonsOkCancel
+)
+
 // NewMessageDialog creates a new message dialog
 func NewMessageDialog(parent *Window, flags DialogFlags, messageType MessageType, buttons ResponseType, message string) *MessageDialog {
+	msgDialog := newMessageDialog(parent, flags, messageType, message)
+
+	// Add buttons
+	if buttons&ResponseOk != 0 {
+		msgDialog.AddButton("OK", ResponseOk)
+	}
+	if buttons&ResponseClose != 0 {
+		msgDialog.AddButton("Close", ResponseClose)
+	}
+	if buttons&ResponseCancel != 0 {
+		msgDialog.AddButton("Cancel", ResponseCancel)
+	}
+	if buttons&ResponseYes != 0 {
+		msgDialog.AddButton("Yes", ResponseYes)
+	}
+	if buttons&ResponseNo != 0 {
+		msgDialog.AddButton("No", ResponseNo)
+	}
+
+	return msgDialog
+}
+
+// NewMessageDialogf creates a new message dialog with a message formatted
+// with fmt.Sprintf, e.g. NewMessageDialogf(win, DialogModal, MessageError,
+// ResponseOk, "Can't open %s: %v", path, err). Without args the format is
+// shown as is, so a message containing % signs is never misformatted, but
+// pass text that isn't a format string, such as user input, to
+// NewMessageDialog instead.
+func NewMessageDialogf(parent *Window, flags DialogFlags, messageType MessageType, buttons ResponseType, format string, args ...interface{}) *MessageDialog {
+	message := format
+	if len(args) > 0 {
+		message = fmt.Sprintf(format, args...)
+	}
+	return NewMessageDialog(parent, flags, messageType, buttons, message)
+}
+
+// NewMessageDialogWithButtons creates a new message dialog with one of the
+// standard button sets. The message is formatted with fmt.Sprintf if args
+// are given. Buttons are ordered with the affirmative one last, which is
+// also the default button activated by Enter: OK for ButtonsOk and
+// ButtonsOkCancel, Yes for ButtonsYesNo, and Close or Cancel otherwise. The
+// responses are ResponseOk, ResponseCancel, ResponseYes, ResponseNo and
+// ResponseClose.
+func NewMessageDialogWithButtons(parent *Window, flags DialogFlags, messageType MessageType, buttons ButtonsType, format string, args ...interface{}) *MessageDialog {
+	message := format
+	if len(args) > 0 {
+		message = fmt.Sprintf(format, args...)
+	}
+
+	msgDialog := newMessageDialog(parent, flags, messageType, message)
+
+	var defaultButton *Button
+	switch buttons {
+	case ButtonsOk:
+		defaultButton = msgDialog.AddButton("OK", ResponseOk)
+	case ButtonsClose:
+		defaultButton = msgDialog.AddButton("Close", ResponseClose)
+	case ButtonsCancel:
+		defaultButton = msgDialog.AddButton("Cancel", ResponseCancel)
+	case ButtonsYesNo:
+		msgDialog.AddButton("No", ResponseNo)
+		defaultButton = msgDialog.AddButton("Yes", ResponseYes)
+	case ButtonsOkCancel:
+		msgDialog.AddButton("Cancel", ResponseCancel)
+		defaultButton = msgDialog.AddButton("OK", ResponseOk)
+	}
+
+	if defaultButton != nil {
+		defaultButton.AddCssClass("suggested-action")
+		C.gtk_window_set_default_widget((*C.GtkWindow)(unsafe.Pointer(msgDialog.widget)), defaultButton.widget)
+		C.gtk_window_set_focus((*C.GtkWindow)(unsafe.Pointer(msgDialog.widget)), defaultButton.widget)
+	}
+
+	return msgDialog
+}
+
+// newMessageDialog creates a message dialog showing the message, without buttons
+func newMessageDialog(parent *Window, flags DialogFlags, messageType MessageType, message string) *MessageDialog {
 	// Create a dialog
 	dialog := NewDialog("", parent, flags)
 
@@ -324,24 +448,43 @@ func NewMessageDialog(parent *Window, flags DialogFlags, messageType MessageType
 	msgLabel.AddCssClass("dialog-message")
 	msgDialog.GetContentArea().Append(msgLabel)
 
-	// Add buttons
-	if buttons&ResponseOk != 0 {
-		msgDialog.AddButton("OK", ResponseOk)
-	}
-	if buttons&ResponseClose != 0 {
-		msgDialog.AddButton("Close", ResponseClose)
-	}
-	if buttons&ResponseCancel != 0 {
-		msgDialog.AddButton("Cancel", ResponseCancel)
-	}
-	if buttons&ResponseYes != 0 {
-		msgDialog.AddButton("Yes", ResponseYes)
-	}
-	if buttons&ResponseNo != 0 {
-		msgDialog.AddButton("No", ResponseNo)
+	return msgDialog
+}
+
+// getSecondaryLabel returns the label for the secondary text, creating it
+// below the message on first use
+func (d *MessageDialog) getSecondaryLabel() *Label {
+	if d.secondaryLabel == nil {
+		d.secondaryLabel = NewLabel("")
+		d.secondaryLabel.AddCssClass("dialog-secondary-text")
+		d.secondaryLabel.AddCssClass("dim-label")
+		C.gtk_label_set_wrap((*C.GtkLabel)(unsafe.Pointer(d.secondaryLabel.widget)), C.TRUE)
+		d.GetContentArea().Append(d.secondaryLabel)
 	}
+	return d.secondaryLabel
+}
 
-	return msgDialog
+// SetSecondaryText sets dimmed text shown below the message, e.g. details
+// for a confirmation question. Calling it again replaces the text; an empty
+// text hides it.
+func (d *MessageDialog) SetSecondaryText(text string) {
+	label := d.getSecondaryLabel()
+	label.SetText(text)
+	label.SetVisible(text != "")
+}
+
+// SetSecondaryMarkup is like SetSecondaryText, but the text is Pango markup,
+// e.g. "The file <b>notes.txt</b> will be deleted."
+func (d *MessageDialog) SetSecondaryMarkup(markup string) {
+	label := d.getSecondaryLabel()
+	label.SetMarkup(markup)
+	label.SetVisible(markup != "")
+}
+
+// ShowAsync shows the message dialog and calls the callback with the
+// user's response, after which the dialog is destroyed; see Dialog.Run
+func (d *MessageDialog) ShowAsync(callback func(responseId ResponseType)) {
+	d.Run(callback)
 }
 
 // FileDialogAction defines the type of file chooser
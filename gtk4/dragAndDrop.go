@@ -0,0 +1,303 @@
+// Package gtk4 provides drag-and-drop functionality for GTK4
+// File: gtk4go/gtk4/dragAndDrop.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// extern GdkContentProvider* dragSourcePrepareCallback(GtkDragSource *source, gdouble x, gdouble y, gpointer user_data);
+// extern gboolean dropTargetDropCallback(GtkDropTarget *target, GValue *value, gdouble x, gdouble y, gpointer user_data);
+//
+// static gulong connectDragSourcePrepare(GtkDragSource *source, guint callbackId) {
+//     return g_signal_connect(source, "prepare", G_CALLBACK(dragSourcePrepareCallback), GUINT_TO_POINTER(callbackId));
+// }
+//
+// static gulong connectDropTargetDrop(GtkDropTarget *target, guint callbackId) {
+//     return g_signal_connect(target, "drop", G_CALLBACK(dropTargetDropCallback), GUINT_TO_POINTER(callbackId));
+// }
+//
+// static GdkContentProvider* newStringContentProvider(const char *text) {
+//     return gdk_content_provider_new_typed(G_TYPE_STRING, text);
+// }
+//
+// // Create a content provider for a list of local file paths
+// static GdkContentProvider* newFileListContentProvider(char **paths, int count) {
+//     GFile **files = g_new0(GFile*, count);
+//     for (int i = 0; i < count; i++) {
+//         files[i] = g_file_new_for_path(paths[i]);
+//     }
+//     GdkFileList *list = gdk_file_list_new_from_array(files, count);
+//     GdkContentProvider *provider = gdk_content_provider_new_typed(GDK_TYPE_FILE_LIST, list);
+//     g_boxed_free(GDK_TYPE_FILE_LIST, list);
+//     for (int i = 0; i < count; i++) {
+//         g_object_unref(files[i]);
+//     }
+//     g_free(files);
+//     return provider;
+// }
+//
+// static GType fileListType() {
+//     return GDK_TYPE_FILE_LIST;
+// }
+//
+// static gboolean valueHoldsFileList(const GValue *value) {
+//     return G_VALUE_HOLDS(value, GDK_TYPE_FILE_LIST);
+// }
+//
+// // Get the files of a file list value as a GSList, which must be freed with g_slist_free
+// static GSList* fileListValueGetFiles(const GValue *value) {
+//     GdkFileList *list = g_value_get_boxed(value);
+//     return list != NULL ? gdk_file_list_get_files(list) : NULL;
+// }
+//
+// // Get the local path of a file, falling back to its URI; free with g_free
+// static char* fileGetPathOrURI(GFile *file) {
+//     char *path = g_file_get_path(file);
+//     return path != NULL ? path : g_file_get_uri(file);
+// }
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// Drag-and-drop signal types
+const (
+	SignalPrepare SignalType = "prepare"
+	SignalDrop    SignalType = "drop"
+)
+
+// GType identifies the type of a value, e.g. the type of data a drop target accepts
+type GType uint64
+
+// Common GTypes
+var (
+	// TypeString is the type of string values
+	TypeString = GType(C.G_TYPE_STRING)
+	// TypeFileList is the type of file lists, as dropped from file managers
+	TypeFileList = GType(C.fileListType())
+)
+
+// DragAction is a bit mask of the actions a drag-and-drop operation may perform
+type DragAction int
+
+const (
+	// DragActionNone performs no action
+	DragActionNone DragAction = 0
+	// DragActionCopy copies the data
+	DragActionCopy DragAction = C.GDK_ACTION_COPY
+	// DragActionMove moves the data, i.e. copies it and deletes it from the source
+	DragActionMove DragAction = C.GDK_ACTION_MOVE
+	// DragActionLink adds a link to the data
+	DragActionLink DragAction = C.GDK_ACTION_LINK
+)
+
+// ContentProvider provides the data of a drag-and-drop operation
+type ContentProvider struct {
+	provider *C.GdkContentProvider
+}
+
+// newContentProvider wraps a content provider, taking ownership of the reference
+func newContentProvider(provider *C.GdkContentProvider) *ContentProvider {
+	contentProvider := &ContentProvider{provider: provider}
+	runtime.SetFinalizer(contentProvider, (*ContentProvider).Destroy)
+	return contentProvider
+}
+
+// NewContentProviderForString creates a content provider for a string
+func NewContentProviderForString(text string) *ContentProvider {
+	var provider *C.GdkContentProvider
+	WithCString(text, func(cText *C.char) {
+		provider = C.newStringContentProvider(cText)
+	})
+	return newContentProvider(provider)
+}
+
+// NewContentProviderForFiles creates a content provider for a list of local
+// file paths, which can be dropped onto file managers and other applications
+func NewContentProviderForFiles(paths []string) *ContentProvider {
+	cPaths := make([]*C.char, len(paths)+1)
+	for i, path := range paths {
+		cPaths[i] = C.CString(path)
+		defer C.free(unsafe.Pointer(cPaths[i]))
+	}
+	return newContentProvider(C.newFileListContentProvider(&cPaths[0], C.int(len(paths))))
+}
+
+// Native returns the underlying GdkContentProvider pointer as uintptr
+func (cp *ContentProvider) Native() uintptr {
+	return uintptr(unsafe.Pointer(cp.provider))
+}
+
+// Destroy releases the content provider
+func (cp *ContentProvider) Destroy() {
+	if cp.provider != nil {
+		C.g_object_unref(C.gpointer(unsafe.Pointer(cp.provider)))
+		cp.provider = nil
+	}
+}
+
+// DragPrepareCallback is called when a drag starts at the given widget
+// coordinates. It returns the content to drag, or nil to cancel the drag.
+type DragPrepareCallback func(x, y float64) *ContentProvider
+
+// DragSource makes a widget a source of drag-and-drop operations.
+// Add it to a widget with AddController.
+type DragSource struct {
+	BaseGesture
+}
+
+// NewDragSource creates a new drag source that offers DragActionCopy
+func NewDragSource() *DragSource {
+	return &DragSource{
+		BaseGesture: BaseGesture{
+			BaseEventController: BaseEventController{
+				controller: (*C.GtkEventController)(unsafe.Pointer(C.gtk_drag_source_new())),
+			},
+		},
+	}
+}
+
+// dragSource returns the controller as a GtkDragSource
+func (ds *DragSource) dragSource() *C.GtkDragSource {
+	return (*C.GtkDragSource)(unsafe.Pointer(ds.controller))
+}
+
+// SetActions sets the actions the drag source offers
+func (ds *DragSource) SetActions(actions DragAction) {
+	C.gtk_drag_source_set_actions(ds.dragSource(), C.GdkDragAction(actions))
+}
+
+// GetActions gets the actions the drag source offers
+func (ds *DragSource) GetActions() DragAction {
+	return DragAction(C.gtk_drag_source_get_actions(ds.dragSource()))
+}
+
+// SetContent sets fixed content to drag. Use ConnectPrepare instead when the
+// content depends on where the drag starts. Passing nil clears the content.
+func (ds *DragSource) SetContent(content *ContentProvider) {
+	if content == nil {
+		C.gtk_drag_source_set_content(ds.dragSource(), nil)
+		return
+	}
+	C.gtk_drag_source_set_content(ds.dragSource(), content.provider)
+}
+
+// ConnectPrepare connects a callback that provides the content when a drag
+// starts. The callback runs synchronously on the UI thread.
+func (ds *DragSource) ConnectPrepare(callback DragPrepareCallback) uint64 {
+	return connectCustomSignal(ds, SignalPrepare, callback, func(id C.guint) C.gulong {
+		return C.connectDragSourcePrepare(ds.dragSource(), id)
+	})
+}
+
+// DropCallback is called when data is dropped at the given widget coordinates.
+// value is a string for TypeString, a []string of paths (or URIs for
+// non-local files) for TypeFileList, or the converted value for other types.
+// Returning true accepts the drop.
+type DropCallback func(value interface{}, x, y float64) bool
+
+// DropTarget makes a widget accept drag-and-drop data of a given type.
+// Add it to a widget with AddController.
+type DropTarget struct {
+	BaseEventController
+}
+
+// NewDropTarget creates a drop target accepting data of the given type,
+// e.g. TypeFileList for files dropped from a file manager
+func NewDropTarget(gtype GType, actions DragAction) *DropTarget {
+	return &DropTarget{
+		BaseEventController: BaseEventController{
+			controller: (*C.GtkEventController)(unsafe.Pointer(
+				C.gtk_drop_target_new(C.GType(gtype), C.GdkDragAction(actions)))),
+		},
+	}
+}
+
+// dropTarget returns the controller as a GtkDropTarget
+func (dt *DropTarget) dropTarget() *C.GtkDropTarget {
+	return (*C.GtkDropTarget)(unsafe.Pointer(dt.controller))
+}
+
+// SetActions sets the actions the drop target supports
+func (dt *DropTarget) SetActions(actions DragAction) {
+	C.gtk_drop_target_set_actions(dt.dropTarget(), C.GdkDragAction(actions))
+}
+
+// ConnectDrop connects a callback for data dropped onto the widget.
+// The callback runs synchronously on the UI thread.
+func (dt *DropTarget) ConnectDrop(callback DropCallback) uint64 {
+	return connectCustomSignal(dt, SignalDrop, callback, func(id C.guint) C.gulong {
+		return C.connectDropTargetDrop(dt.dropTarget(), id)
+	})
+}
+
+// dropValueToGo converts a dropped value to a Go value
+func dropValueToGo(value *C.GValue) interface{} {
+	if C.valueHoldsFileList(value) == C.FALSE {
+		return ValueFromGValue(value)
+	}
+
+	files := C.fileListValueGetFiles(value)
+	defer C.g_slist_free(files)
+
+	paths := []string{}
+	for node := files; node != nil; node = node.next {
+		cPath := C.fileGetPathOrURI((*C.GFile)(node.data))
+		if cPath == nil {
+			continue
+		}
+		paths = append(paths, C.GoString(cPath))
+		C.g_free(C.gpointer(unsafe.Pointer(cPath)))
+	}
+	return paths
+}
+
+//export dragSourcePrepareCallback
+func dragSourcePrepareCallback(source *C.GtkDragSource, x, y C.gdouble, userData C.gpointer) *C.GdkContentProvider {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return nil
+	}
+
+	cb, ok := callback.(DragPrepareCallback)
+	if !ok {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"dragSourcePrepareCallback: callback has wrong type: %T", callback)
+		return nil
+	}
+
+	// The content is needed immediately, so the callback is executed directly
+	content := cb(float64(x), float64(y))
+	if content == nil || content.provider == nil {
+		return nil
+	}
+
+	// GTK takes ownership of the returned reference
+	C.g_object_ref(C.gpointer(unsafe.Pointer(content.provider)))
+	return content.provider
+}
+
+//export dropTargetDropCallback
+func dropTargetDropCallback(target *C.GtkDropTarget, value *C.GValue, x, y C.gdouble, userData C.gpointer) C.gboolean {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return C.FALSE
+	}
+
+	cb, ok := callback.(DropCallback)
+	if !ok {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"dropTargetDropCallback: callback has wrong type: %T", callback)
+		return C.FALSE
+	}
+
+	// The value is only valid during the signal emission and the result
+	// decides whether the drop is accepted, so the callback is executed directly
+	if cb(dropValueToGo(value), float64(x), float64(y)) {
+		return C.TRUE
+	}
+	return C.FALSE
+}
@@ -0,0 +1,234 @@
+// Package gtk4 provides drawing area functionality for GTK4
+// File: gtk4go/gtk4/drawingArea.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// extern void drawingAreaDrawCallback(GtkDrawingArea *area, cairo_t *cr, int width, int height, gpointer user_data);
+// extern void drawingAreaDestroyNotify(gpointer user_data);
+//
+// static void setDrawFunc(GtkDrawingArea *area, guint key) {
+//     gtk_drawing_area_set_draw_func(area, drawingAreaDrawCallback, GUINT_TO_POINTER(key), drawingAreaDestroyNotify);
+// }
+import "C"
+
+import (
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// DrawFunc is called to draw the contents of a drawing area.
+// It always runs on the UI thread.
+type DrawFunc func(cr *CairoContext, width, height int)
+
+var (
+	// drawFuncs maps draw function keys to registered draw functions
+	drawFuncs       sync.Map
+	nextDrawFuncKey atomic.Uint64
+)
+
+// DrawingAreaOption is a function that configures a drawing area
+type DrawingAreaOption func(*DrawingArea)
+
+// DrawingArea represents a GTK drawing area, a widget for custom drawing
+type DrawingArea struct {
+	BaseWidget
+	drawQueued atomic.Bool
+}
+
+// NewDrawingArea creates a new GTK drawing area
+func NewDrawingArea(options ...DrawingAreaOption) *DrawingArea {
+	drawingArea := &DrawingArea{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_drawing_area_new(),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(drawingArea)
+	}
+
+	SetupFinalization(drawingArea, drawingArea.Destroy)
+	return drawingArea
+}
+
+// WithContentSize sets the preferred content size of the drawing area
+func WithContentSize(width, height int) DrawingAreaOption {
+	return func(da *DrawingArea) {
+		da.SetContentWidth(width)
+		da.SetContentHeight(height)
+	}
+}
+
+// WithDrawFunc sets the draw function of the drawing area
+func WithDrawFunc(drawFunc DrawFunc) DrawingAreaOption {
+	return func(da *DrawingArea) {
+		da.SetDrawFunc(drawFunc)
+	}
+}
+
+// SetContentWidth sets the preferred width of the drawing area's content
+func (da *DrawingArea) SetContentWidth(width int) {
+	C.gtk_drawing_area_set_content_width((*C.GtkDrawingArea)(unsafe.Pointer(da.widget)), C.int(width))
+}
+
+// GetContentWidth gets the preferred width of the drawing area's content
+func (da *DrawingArea) GetContentWidth() int {
+	return int(C.gtk_drawing_area_get_content_width((*C.GtkDrawingArea)(unsafe.Pointer(da.widget))))
+}
+
+// SetContentHeight sets the preferred height of the drawing area's content
+func (da *DrawingArea) SetContentHeight(height int) {
+	C.gtk_drawing_area_set_content_height((*C.GtkDrawingArea)(unsafe.Pointer(da.widget)), C.int(height))
+}
+
+// GetContentHeight gets the preferred height of the drawing area's content
+func (da *DrawingArea) GetContentHeight() int {
+	return int(C.gtk_drawing_area_get_content_height((*C.GtkDrawingArea)(unsafe.Pointer(da.widget))))
+}
+
+// SetDrawFunc sets the function used to draw the drawing area's contents,
+// replacing any previous one. Passing nil removes the draw function.
+func (da *DrawingArea) SetDrawFunc(drawFunc DrawFunc) {
+	area := (*C.GtkDrawingArea)(unsafe.Pointer(da.widget))
+
+	if drawFunc == nil {
+		// Clearing the draw func triggers the destroy notify of the old one
+		C.gtk_drawing_area_set_draw_func(area, nil, nil, nil)
+		return
+	}
+
+	key := nextDrawFuncKey.Add(1)
+	drawFuncs.Store(key, drawFunc)
+	C.setDrawFunc(area, C.guint(key))
+}
+
+// QueueDraw schedules a redraw of the drawing area.
+// It is safe to call from any goroutine. Calls made before the pending
+// request reaches the UI thread are coalesced into a single redraw, so it
+// can be called freely from timers and background updates.
+func (da *DrawingArea) QueueDraw() {
+	if !da.drawQueued.CompareAndSwap(false, true) {
+		// A redraw is already on its way to the UI thread
+		return
+	}
+
+	RunOnUIThread(func() {
+		da.drawQueued.Store(false)
+		if da.widget != nil {
+			C.gtk_widget_queue_draw(da.widget)
+		}
+	})
+}
+
+// Destroy destroys the drawing area and cleans up resources
+func (da *DrawingArea) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(da)
+
+	// Call base destroy method
+	da.BaseWidget.Destroy()
+}
+
+//export drawingAreaDrawCallback
+func drawingAreaDrawCallback(area *C.GtkDrawingArea, cr *C.cairo_t, width, height C.int, userData C.gpointer) {
+	key := uint64(uintptr(userData))
+	value, ok := drawFuncs.Load(key)
+	if !ok {
+		return
+	}
+
+	// The Cairo context is only valid for the duration of the draw call
+	value.(DrawFunc)(&CairoContext{cr: cr}, int(width), int(height))
+}
+
+//export drawingAreaDestroyNotify
+func drawingAreaDestroyNotify(userData C.gpointer) {
+	// Called when the draw func is replaced or the drawing area is destroyed
+	drawFuncs.Delete(uint64(uintptr(userData)))
+}
+
+// CairoContext wraps a Cairo drawing context passed to a draw function.
+// It must not be used after the draw function returns.
+type CairoContext struct {
+	cr *C.cairo_t
+}
+
+// SetSourceRGBA sets the color used for subsequent drawing operations.
+// Components range from 0.0 to 1.0.
+func (c *CairoContext) SetSourceRGBA(red, green, blue, alpha float64) {
+	C.cairo_set_source_rgba(c.cr, C.double(red), C.double(green), C.double(blue), C.double(alpha))
+}
+
+// SetSourceRGB sets an opaque color used for subsequent drawing operations.
+// Components range from 0.0 to 1.0.
+func (c *CairoContext) SetSourceRGB(red, green, blue float64) {
+	C.cairo_set_source_rgb(c.cr, C.double(red), C.double(green), C.double(blue))
+}
+
+// SetLineWidth sets the width of lines drawn by Stroke
+func (c *CairoContext) SetLineWidth(width float64) {
+	C.cairo_set_line_width(c.cr, C.double(width))
+}
+
+// MoveTo begins a new sub-path at the given point
+func (c *CairoContext) MoveTo(x, y float64) {
+	C.cairo_move_to(c.cr, C.double(x), C.double(y))
+}
+
+// LineTo adds a line from the current point to the given point
+func (c *CairoContext) LineTo(x, y float64) {
+	C.cairo_line_to(c.cr, C.double(x), C.double(y))
+}
+
+// Stroke draws the current path with the current line width and source,
+// then clears the path
+func (c *CairoContext) Stroke() {
+	C.cairo_stroke(c.cr)
+}
+
+// StrokePreserve draws the current path like Stroke but keeps the path,
+// so it can also be filled
+func (c *CairoContext) StrokePreserve() {
+	C.cairo_stroke_preserve(c.cr)
+}
+
+// Rectangle adds a closed rectangle sub-path to the current path
+func (c *CairoContext) Rectangle(x, y, width, height float64) {
+	C.cairo_rectangle(c.cr, C.double(x), C.double(y), C.double(width), C.double(height))
+}
+
+// ClosePath adds a line from the current point back to the start of the sub-path
+func (c *CairoContext) ClosePath() {
+	C.cairo_close_path(c.cr)
+}
+
+// Fill fills the current path with the current source, then clears the path
+func (c *CairoContext) Fill() {
+	C.cairo_fill(c.cr)
+}
+
+// FillPreserve fills the current path like Fill but keeps the path,
+// so it can also be stroked
+func (c *CairoContext) FillPreserve() {
+	C.cairo_fill_preserve(c.cr)
+}
+
+// Paint fills the whole drawing area with the current source
+func (c *CairoContext) Paint() {
+	C.cairo_paint(c.cr)
+}
+
+// Save pushes the current drawing state (source, line width, etc.) onto a stack
+func (c *CairoContext) Save() {
+	C.cairo_save(c.cr)
+}
+
+// Restore restores the drawing state saved by the matching Save
+func (c *CairoContext) Restore() {
+	C.cairo_restore(c.cr)
+}
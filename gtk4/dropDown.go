@@ -0,0 +1,195 @@
+// Package gtk4 provides drop-down functionality for GTK4
+// File: gtk4go/gtk4/dropDown.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// extern void dropDownSelectedCallback(GObject *object, GParamSpec *pspec, gpointer user_data);
+//
+// static gulong connectDropDownSelected(GtkDropDown *dropDown, guint callbackId) {
+//     return g_signal_connect(dropDown, "notify::selected", G_CALLBACK(dropDownSelectedCallback), GUINT_TO_POINTER(callbackId));
+// }
+//
+// static int dropDownGetSelected(GtkDropDown *dropDown) {
+//     guint selected = gtk_drop_down_get_selected(dropDown);
+//     return selected == GTK_INVALID_LIST_POSITION ? -1 : (int)selected;
+// }
+//
+// static void dropDownSetSelected(GtkDropDown *dropDown, int position) {
+//     gtk_drop_down_set_selected(dropDown, position < 0 ? GTK_INVALID_LIST_POSITION : (guint)position);
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+// SignalSelectedChanged is emitted when the selected item of a drop-down changes
+const SignalSelectedChanged SignalType = "notify::selected"
+
+// DropDownOption is a function that configures a drop-down
+type DropDownOption func(*DropDown)
+
+// DropDown represents a GTK drop-down, which lets the user choose an item from a list model
+type DropDown struct {
+	BaseWidget
+	model   ListModel
+	factory ListItemFactory
+}
+
+// NewDropDown creates a new drop-down showing the items of a model.
+// A StringList is displayed as-is; other models need a factory (see WithDropDownFactory).
+func NewDropDown(model ListModel, options ...DropDownOption) *DropDown {
+	dropDown := &DropDown{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_drop_down_new(nil, nil),
+		},
+	}
+
+	dropDown.SetModel(model)
+
+	// Apply options
+	for _, option := range options {
+		option(dropDown)
+	}
+
+	SetupFinalization(dropDown, dropDown.Destroy)
+	return dropDown
+}
+
+// NewDropDownFromStrings creates a new drop-down with a list of strings
+func NewDropDownFromStrings(items []string, options ...DropDownOption) *DropDown {
+	list := NewStringList()
+	for _, item := range items {
+		list.Append(item)
+	}
+	return NewDropDown(list, options...)
+}
+
+// WithDropDownFactory sets the factory used to create the drop-down's items
+func WithDropDownFactory(factory ListItemFactory) DropDownOption {
+	return func(d *DropDown) {
+		d.SetFactory(factory)
+	}
+}
+
+// WithDropDownSelected sets the initially selected position
+func WithDropDownSelected(position int) DropDownOption {
+	return func(d *DropDown) {
+		d.SetSelected(position)
+	}
+}
+
+// WithDropDownSearch enables searching the drop-down's items
+func WithDropDownSearch(enableSearch bool) DropDownOption {
+	return func(d *DropDown) {
+		var cEnableSearch C.gboolean
+		if enableSearch {
+			cEnableSearch = C.TRUE
+		} else {
+			cEnableSearch = C.FALSE
+		}
+		C.gtk_drop_down_set_enable_search(d.dropDown(), cEnableSearch)
+	}
+}
+
+// dropDown returns the widget as a GtkDropDown
+func (d *DropDown) dropDown() *C.GtkDropDown {
+	return (*C.GtkDropDown)(unsafe.Pointer(d.widget))
+}
+
+// SetModel sets the model of the drop-down
+func (d *DropDown) SetModel(model ListModel) {
+	if model != nil {
+		C.gtk_drop_down_set_model(d.dropDown(), model.GetListModel())
+	} else {
+		C.gtk_drop_down_set_model(d.dropDown(), nil)
+	}
+	d.model = model
+}
+
+// GetModel returns the model of the drop-down
+func (d *DropDown) GetModel() ListModel {
+	return d.model
+}
+
+// SetFactory sets the factory used to create the drop-down's items
+func (d *DropDown) SetFactory(factory ListItemFactory) {
+	if factory != nil {
+		C.gtk_drop_down_set_factory(d.dropDown(), factory.GetListItemFactory())
+	} else {
+		C.gtk_drop_down_set_factory(d.dropDown(), nil)
+	}
+	d.factory = factory
+}
+
+// GetSelected returns the selected position, or -1 if nothing is selected
+func (d *DropDown) GetSelected() int {
+	return int(C.dropDownGetSelected(d.dropDown()))
+}
+
+// SetSelected selects the item at the given position; -1 clears the selection
+func (d *DropDown) SetSelected(position int) {
+	C.dropDownSetSelected(d.dropDown(), C.int(position))
+}
+
+// GetSelectedValue returns the selected item as returned by the model's
+// GetItem, e.g. a string for a StringList, or nil if nothing is selected
+func (d *DropDown) GetSelectedValue() interface{} {
+	return d.valueAt(d.GetSelected())
+}
+
+// valueAt resolves a position to the model's Go value
+func (d *DropDown) valueAt(position int) interface{} {
+	if position < 0 || d.model == nil {
+		return nil
+	}
+	return d.model.GetItem(position)
+}
+
+// ConnectSelectionChanged connects a callback for changes of the selected
+// position. The position is -1 when nothing is selected.
+func (d *DropDown) ConnectSelectionChanged(callback func(position int)) uint64 {
+	return connectCustomSignal(d, SignalSelectedChanged, callback, func(id C.guint) C.gulong {
+		return C.connectDropDownSelected(d.dropDown(), id)
+	})
+}
+
+// ConnectSelectedValueChanged connects a callback for changes of the selected
+// item, which is passed as returned by the model's GetItem, or nil when
+// nothing is selected
+func (d *DropDown) ConnectSelectedValueChanged(callback func(value interface{})) uint64 {
+	return d.ConnectSelectionChanged(func(position int) {
+		callback(d.valueAt(position))
+	})
+}
+
+// Destroy destroys the drop-down and cleans up resources
+func (d *DropDown) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(d)
+
+	d.model = nil
+	d.factory = nil
+
+	// Call base destroy method
+	d.BaseWidget.Destroy()
+}
+
+//export dropDownSelectedCallback
+func dropDownSelectedCallback(object *C.GObject, pspec *C.GParamSpec, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	if cb, ok := callback.(func(int)); ok {
+		selected := int(C.dropDownGetSelected((*C.GtkDropDown)(unsafe.Pointer(object))))
+		SafeCallback(cb, selected)
+	} else {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"dropDownSelectedCallback: callback has wrong type: %T", callback)
+	}
+}
@@ -136,6 +136,24 @@ func (e *Entry) ConnectChanged(callback func()) {
 	Connect(e, SignalChanged, callback)
 }
 
+// ConnectChangedWithText connects a callback to the entry's "changed" signal
+// that receives the new text. If the callback changes the text itself, e.g.
+// to normalize the input, the "changed" signal this fires while the callback
+// runs is not delivered to it again, so it can't recurse. The returned ID can
+// be used with Disconnect.
+func (e *Entry) ConnectChangedWithText(callback func(text string)) uint64 {
+	running := false
+	return Connect(e, SignalChanged, func() {
+		if running {
+			return
+		}
+		running = true
+		defer func() { running = false }()
+
+		callback(e.GetText())
+	})
+}
+
 // ConnectActivate connects a callback function to the entry's "activate" signal
 func (e *Entry) ConnectActivate(callback func()) {
 	Connect(e, SignalActivate, callback)
@@ -341,4 +359,49 @@ func (e *Entry) SetEnableUndo(enabled bool) {
 // GetEnableUndo gets whether the user can undo/redo entry edits
 func (e *Entry) GetEnableUndo() bool {
 	return C.gtk_editable_get_enable_undo((*C.GtkEditable)(unsafe.Pointer(e.widget))) == C.TRUE
-}
\ No newline at end of file
+}
+
+// SetMaxLength sets the maximum number of characters the user can enter;
+// 0 means no limit. Longer text already in the entry is truncated.
+func (e *Entry) SetMaxLength(length int) {
+	C.gtk_entry_set_max_length((*C.GtkEntry)(unsafe.Pointer(e.widget)), C.int(length))
+}
+
+// GetMaxLength gets the maximum number of characters; 0 means no limit
+func (e *Entry) GetMaxLength() int {
+	return int(C.gtk_entry_get_max_length((*C.GtkEntry)(unsafe.Pointer(e.widget))))
+}
+
+// SetPosition moves the cursor before the character at the given position.
+// Positions count characters, not bytes, so they differ from Go string
+// indices for multibyte text; -1 moves the cursor to the end of the text.
+func (e *Entry) SetPosition(position int) {
+	C.gtk_editable_set_position((*C.GtkEditable)(unsafe.Pointer(e.widget)), C.int(position))
+}
+
+// GetPosition gets the cursor position, in characters
+func (e *Entry) GetPosition() int {
+	return int(C.gtk_editable_get_position((*C.GtkEditable)(unsafe.Pointer(e.widget))))
+}
+
+// SelectRegion selects the characters from start up to, but not including,
+// end. Positions count characters, not bytes; an end of -1 selects up to the
+// end of the text. The cursor is moved to end.
+func (e *Entry) SelectRegion(start, end int) {
+	C.gtk_editable_select_region((*C.GtkEditable)(unsafe.Pointer(e.widget)), C.int(start), C.int(end))
+}
+
+// SelectAll selects all text, e.g. when a search entry is focused so that
+// typing replaces the previous query
+func (e *Entry) SelectAll() {
+	e.SelectRegion(0, -1)
+}
+
+// GetSelectionBounds gets the selected characters as start and end
+// positions, and whether any text is selected. Without a selection, start and
+// end are both the cursor position.
+func (e *Entry) GetSelectionBounds() (start, end int, selected bool) {
+	var cStart, cEnd C.int
+	cSelected := C.gtk_editable_get_selection_bounds((*C.GtkEditable)(unsafe.Pointer(e.widget)), &cStart, &cEnd)
+	return int(cStart), int(cEnd), cSelected == C.TRUE
+}
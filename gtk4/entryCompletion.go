@@ -0,0 +1,331 @@
+// Package gtk4 provides entry completion functionality for GTK4
+// File: gtk4go/gtk4/entryCompletion.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// // GtkEntryCompletion is deprecated since GTK 4.10 without a replacement
+// G_GNUC_BEGIN_IGNORE_DEPRECATIONS
+//
+// #define ACTIVATE_ON_MATCH_KEY "gtk4go-activate-on-match"
+//
+// extern gboolean entryCompletionMatchSelectedCallback(GtkEntryCompletion *completion, GtkTreeModel *model, GtkTreeIter *iter, gpointer user_data);
+//
+// // Mirror changes of a list model of strings into the completion's store
+// static void syncCompletionStore(GListModel *model, guint position, guint removed, guint added, gpointer user_data) {
+//     GtkListStore *store = GTK_LIST_STORE(user_data);
+//     GtkTreeIter iter;
+//
+//     if (removed > 0 && gtk_tree_model_iter_nth_child(GTK_TREE_MODEL(store), &iter, NULL, position)) {
+//         // gtk_list_store_remove moves the iter to the next row
+//         for (guint i = 0; i < removed; i++) {
+//             if (!gtk_list_store_remove(store, &iter)) {
+//                 break;
+//             }
+//         }
+//     }
+//
+//     for (guint i = 0; i < added; i++) {
+//         gpointer item = g_list_model_get_item(model, position + i);
+//         const char *text = "";
+//         if (item != NULL && GTK_IS_STRING_OBJECT(item)) {
+//             text = gtk_string_object_get_string(GTK_STRING_OBJECT(item));
+//         }
+//         gtk_list_store_insert_with_values(store, NULL, position + i, 0, text, -1);
+//         if (item != NULL) {
+//             g_object_unref(item);
+//         }
+//     }
+// }
+//
+// static GtkEntryCompletion* createEntryCompletion(GListModel *model) {
+//     GtkEntryCompletion *completion = gtk_entry_completion_new();
+//     GtkListStore *store = gtk_list_store_new(1, G_TYPE_STRING);
+//
+//     if (model != NULL) {
+//         syncCompletionStore(model, 0, 0, g_list_model_get_n_items(model), store);
+//         // Disconnected automatically when the store is finalized
+//         g_signal_connect_object(model, "items-changed", G_CALLBACK(syncCompletionStore), store, 0);
+//     }
+//
+//     gtk_entry_completion_set_model(completion, GTK_TREE_MODEL(store));
+//     gtk_entry_completion_set_text_column(completion, 0);
+//     g_object_unref(store);
+//     return completion;
+// }
+//
+// static gulong connectMatchSelected(GtkEntryCompletion *completion, guint callbackId) {
+//     return g_signal_connect(completion, "match-selected", G_CALLBACK(entryCompletionMatchSelectedCallback), GUINT_TO_POINTER(callbackId));
+// }
+//
+// // Resolve a match to the store, as the popup passes rows of a filter model
+// static void matchToStore(GtkTreeModel **model, GtkTreeIter *iter) {
+//     if (GTK_IS_TREE_MODEL_FILTER(*model)) {
+//         GtkTreeIter child;
+//         gtk_tree_model_filter_convert_iter_to_child_iter(GTK_TREE_MODEL_FILTER(*model), &child, iter);
+//         *iter = child;
+//         *model = gtk_tree_model_filter_get_model(GTK_TREE_MODEL_FILTER(*model));
+//     }
+// }
+//
+// static int matchPosition(GtkTreeModel *model, GtkTreeIter *iter) {
+//     matchToStore(&model, iter);
+//     GtkTreePath *path = gtk_tree_model_get_path(model, iter);
+//     int position = gtk_tree_path_get_indices(path)[0];
+//     gtk_tree_path_free(path);
+//     return position;
+// }
+//
+// static void completionSetActivateOnMatch(GtkEntryCompletion *completion, gboolean activate) {
+//     g_object_set_data(G_OBJECT(completion), ACTIVATE_ON_MATCH_KEY, GINT_TO_POINTER(activate));
+// }
+//
+// static gboolean completionGetActivateOnMatch(GtkEntryCompletion *completion) {
+//     return GPOINTER_TO_INT(g_object_get_data(G_OBJECT(completion), ACTIVATE_ON_MATCH_KEY));
+// }
+//
+// // Fill the entry with a match and activate it
+// static void completionFillAndActivate(GtkEntryCompletion *completion, GtkTreeModel *model, GtkTreeIter *iter) {
+//     GtkWidget *entry = gtk_entry_completion_get_entry(completion);
+//     if (entry == NULL) {
+//         return;
+//     }
+//
+//     char *text = NULL;
+//     gtk_tree_model_get(model, iter, 0, &text, -1);
+//     gtk_editable_set_text(GTK_EDITABLE(entry), text != NULL ? text : "");
+//     gtk_editable_set_position(GTK_EDITABLE(entry), -1);
+//     g_free(text);
+//
+//     gtk_widget_activate(entry);
+// }
+//
+// static void entrySetCompletion(GtkEntry *entry, GtkEntryCompletion *completion) {
+//     gtk_entry_set_completion(entry, completion);
+// }
+//
+// static void entryCompletionSetMinimumKeyLength(GtkEntryCompletion *completion, int length) {
+//     gtk_entry_completion_set_minimum_key_length(completion, length);
+// }
+//
+// static int entryCompletionGetMinimumKeyLength(GtkEntryCompletion *completion) {
+//     return gtk_entry_completion_get_minimum_key_length(completion);
+// }
+//
+// static void entryCompletionSetInlineCompletion(GtkEntryCompletion *completion, gboolean inline_completion) {
+//     gtk_entry_completion_set_inline_completion(completion, inline_completion);
+// }
+//
+// static gboolean entryCompletionGetInlineCompletion(GtkEntryCompletion *completion) {
+//     return gtk_entry_completion_get_inline_completion(completion);
+// }
+//
+// static void entryCompletionSetPopupCompletion(GtkEntryCompletion *completion, gboolean popup_completion) {
+//     gtk_entry_completion_set_popup_completion(completion, popup_completion);
+// }
+//
+// G_GNUC_END_IGNORE_DEPRECATIONS
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// SignalMatchSelected is emitted when the user selects a completion match
+const SignalMatchSelected SignalType = "match-selected"
+
+// EntryCompletionOption is a function that configures an entry completion
+type EntryCompletionOption func(*EntryCompletion)
+
+// EntryCompletion represents a GTK entry completion, which suggests matching
+// strings in a popup while the user types into an Entry
+type EntryCompletion struct {
+	completion *C.GtkEntryCompletion
+	model      ListModel
+	matchID    uint64 // Handler activating the entry while no callback is connected
+}
+
+// NewEntryCompletion creates a new entry completion suggesting the strings of
+// a model, typically a StringList. Changes to the model are reflected in the
+// suggestions.
+func NewEntryCompletion(model ListModel, options ...EntryCompletionOption) *EntryCompletion {
+	var cModel *C.GListModel
+	if model != nil {
+		cModel = model.GetListModel()
+	}
+
+	completion := &EntryCompletion{
+		completion: C.createEntryCompletion(cModel),
+		model:      model,
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(completion)
+	}
+
+	runtime.SetFinalizer(completion, (*EntryCompletion).Destroy)
+	return completion
+}
+
+// WithMinimumKeyLength sets how many characters must be typed before suggestions are shown
+func WithMinimumKeyLength(length int) EntryCompletionOption {
+	return func(c *EntryCompletion) {
+		c.SetMinimumKeyLength(length)
+	}
+}
+
+// WithInlineCompletion sets whether the common prefix of the matches is inserted inline
+func WithInlineCompletion(inline bool) EntryCompletionOption {
+	return func(c *EntryCompletion) {
+		c.SetInlineCompletion(inline)
+	}
+}
+
+// WithActivateOnMatch sets whether selecting a match also activates the entry
+func WithActivateOnMatch(activate bool) EntryCompletionOption {
+	return func(c *EntryCompletion) {
+		c.SetActivateOnMatch(activate)
+	}
+}
+
+// Native returns the underlying GtkEntryCompletion pointer as uintptr
+func (c *EntryCompletion) Native() uintptr {
+	return uintptr(unsafe.Pointer(c.completion))
+}
+
+// GetModel returns the model the suggestions are taken from
+func (c *EntryCompletion) GetModel() ListModel {
+	return c.model
+}
+
+// SetMinimumKeyLength sets how many characters must be typed before suggestions are shown
+func (c *EntryCompletion) SetMinimumKeyLength(length int) {
+	C.entryCompletionSetMinimumKeyLength(c.completion, C.int(length))
+}
+
+// GetMinimumKeyLength returns how many characters must be typed before suggestions are shown
+func (c *EntryCompletion) GetMinimumKeyLength() int {
+	return int(C.entryCompletionGetMinimumKeyLength(c.completion))
+}
+
+// SetInlineCompletion sets whether the common prefix of the matches is inserted inline
+func (c *EntryCompletion) SetInlineCompletion(inline bool) {
+	var cinline C.gboolean
+	if inline {
+		cinline = C.TRUE
+	} else {
+		cinline = C.FALSE
+	}
+	C.entryCompletionSetInlineCompletion(c.completion, cinline)
+}
+
+// GetInlineCompletion returns whether the common prefix of the matches is inserted inline
+func (c *EntryCompletion) GetInlineCompletion() bool {
+	return C.entryCompletionGetInlineCompletion(c.completion) == C.TRUE
+}
+
+// SetPopupCompletion sets whether the matches are shown in a popup
+func (c *EntryCompletion) SetPopupCompletion(popup bool) {
+	var cpopup C.gboolean
+	if popup {
+		cpopup = C.TRUE
+	} else {
+		cpopup = C.FALSE
+	}
+	C.entryCompletionSetPopupCompletion(c.completion, cpopup)
+}
+
+// SetActivateOnMatch sets whether selecting a match fills the entry and then
+// activates it, as if the user pressed Enter, unless a ConnectMatchSelected
+// callback handled the match
+func (c *EntryCompletion) SetActivateOnMatch(activate bool) {
+	var cactivate C.gboolean
+	if activate {
+		cactivate = C.TRUE
+	} else {
+		cactivate = C.FALSE
+	}
+	C.completionSetActivateOnMatch(c.completion, cactivate)
+
+	// The match-selected handler performs the activation, so one is needed
+	// even if no callback is connected
+	if activate && c.matchID == 0 && len(getCallbackIDsForSignal(c.Native(), SignalMatchSelected)) == 0 {
+		c.matchID = c.ConnectMatchSelected(func(int) bool {
+			return false
+		})
+	}
+}
+
+// ConnectMatchSelected connects a callback for when the user selects a match,
+// which receives the match's position in the model. Returning true means the
+// callback handled the match; returning false fills the entry with it (and
+// activates it, see SetActivateOnMatch).
+func (c *EntryCompletion) ConnectMatchSelected(callback func(position int) bool) uint64 {
+	// Replace the handler added by SetActivateOnMatch, which would otherwise
+	// complete the match before this callback runs
+	if c.matchID != 0 {
+		Disconnect(c.matchID)
+		c.matchID = 0
+	}
+
+	return connectCustomSignal(c, SignalMatchSelected, callback, func(id C.guint) C.gulong {
+		return C.connectMatchSelected(c.completion, id)
+	})
+}
+
+// Destroy releases the entry completion; entries using it keep their own reference
+func (c *EntryCompletion) Destroy() {
+	if c.completion != nil {
+		DisconnectAll(c)
+
+		C.g_object_unref(C.gpointer(unsafe.Pointer(c.completion)))
+		c.completion = nil
+	}
+	c.model = nil
+}
+
+// SetCompletion sets the completion that suggests text while the user types;
+// nil removes it
+func (e *Entry) SetCompletion(completion *EntryCompletion) {
+	if completion == nil {
+		C.entrySetCompletion((*C.GtkEntry)(unsafe.Pointer(e.widget)), nil)
+		return
+	}
+	C.entrySetCompletion((*C.GtkEntry)(unsafe.Pointer(e.widget)), completion.completion)
+}
+
+//export entryCompletionMatchSelectedCallback
+func entryCompletionMatchSelectedCallback(completion *C.GtkEntryCompletion, model *C.GtkTreeModel, iter *C.GtkTreeIter, userData C.gpointer) C.gboolean {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return C.FALSE
+	}
+
+	cb, ok := callback.(func(int) bool)
+	if !ok {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"entryCompletionMatchSelectedCallback: callback has wrong type: %T", callback)
+		return C.FALSE
+	}
+
+	// Copy the iter, as resolving the position converts it to the store
+	storeModel := model
+	storeIter := *iter
+	position := int(C.matchPosition(storeModel, &storeIter))
+
+	// The result is needed before returning, so the callback runs directly;
+	// the signal is emitted on the UI thread
+	if cb(position) {
+		return C.TRUE
+	}
+
+	if C.completionGetActivateOnMatch(completion) == C.TRUE {
+		C.completionFillAndActivate(completion, model, iter)
+		return C.TRUE
+	}
+	return C.FALSE
+}
@@ -0,0 +1,287 @@
+// Package gtk4 provides event controller functionality for GTK4
+// File: gtk4go/gtk4/eventController.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// extern void controllerWeakNotify(gpointer data, GObject *object);
+//
+// // Get notified when a tracked controller is finalized, e.g. because GTK
+// // destroyed its widget, so its Go handlers can be released
+// static void watchController(GtkEventController *controller) {
+//     g_object_weak_ref(G_OBJECT(controller), (GWeakNotify)controllerWeakNotify, NULL);
+// }
+//
+// static void unwatchController(GtkEventController *controller) {
+//     g_object_weak_unref(G_OBJECT(controller), (GWeakNotify)controllerWeakNotify, NULL);
+// }
+import "C"
+
+import (
+	"fmt"
+	"sync"
+	"unsafe"
+)
+
+// PropagationPhase defines the phase in which an event controller handles events
+type PropagationPhase int
+
+const (
+	// PropagationPhaseNone means events are not handled automatically
+	PropagationPhaseNone PropagationPhase = C.GTK_PHASE_NONE
+	// PropagationPhaseCapture handles events on the way from the toplevel down to the target
+	PropagationPhaseCapture PropagationPhase = C.GTK_PHASE_CAPTURE
+	// PropagationPhaseBubble handles events on the way from the target up to the toplevel
+	PropagationPhaseBubble PropagationPhase = C.GTK_PHASE_BUBBLE
+	// PropagationPhaseTarget handles events only on the target widget
+	PropagationPhaseTarget PropagationPhase = C.GTK_PHASE_TARGET
+)
+
+// ModifierType is a bit mask of keyboard modifiers and mouse buttons held
+// down during an event
+type ModifierType uint
+
+const (
+	// ModifierShift is the Shift key
+	ModifierShift ModifierType = C.GDK_SHIFT_MASK
+	// ModifierLock is the Caps Lock key
+	ModifierLock ModifierType = C.GDK_LOCK_MASK
+	// ModifierControl is the Control key
+	ModifierControl ModifierType = C.GDK_CONTROL_MASK
+	// ModifierAlt is the Alt key
+	ModifierAlt ModifierType = C.GDK_ALT_MASK
+	// ModifierSuper is the Super (Windows/Command) key
+	ModifierSuper ModifierType = C.GDK_SUPER_MASK
+	// ModifierHyper is the Hyper key
+	ModifierHyper ModifierType = C.GDK_HYPER_MASK
+	// ModifierMeta is the Meta key
+	ModifierMeta ModifierType = C.GDK_META_MASK
+	// ModifierButton1 is the first (usually left) mouse button
+	ModifierButton1 ModifierType = C.GDK_BUTTON1_MASK
+	// ModifierButton2 is the second (usually middle) mouse button
+	ModifierButton2 ModifierType = C.GDK_BUTTON2_MASK
+	// ModifierButton3 is the third (usually right) mouse button
+	ModifierButton3 ModifierType = C.GDK_BUTTON3_MASK
+)
+
+// Has returns whether all of the given modifiers are set in the mask
+func (m ModifierType) Has(modifiers ModifierType) bool {
+	return m&modifiers == modifiers
+}
+
+// Mouse button numbers
+const (
+	// ButtonAny matches any mouse button when used with SetButton
+	ButtonAny = 0
+	// ButtonPrimary is the primary (usually left) mouse button
+	ButtonPrimary = C.GDK_BUTTON_PRIMARY
+	// ButtonMiddle is the middle mouse button
+	ButtonMiddle = C.GDK_BUTTON_MIDDLE
+	// ButtonSecondary is the secondary (usually right) mouse button
+	ButtonSecondary = C.GDK_BUTTON_SECONDARY
+)
+
+// EventController defines the common interface for GTK event controllers
+type EventController interface {
+	Object
+
+	// GetEventController returns the underlying GtkEventController pointer
+	GetEventController() *C.GtkEventController
+}
+
+// BaseEventController provides common functionality for event controllers.
+// Once a controller has been added to a widget with AddController, the widget
+// owns it and frees it when the widget is destroyed or the controller is removed.
+type BaseEventController struct {
+	controller *C.GtkEventController
+}
+
+// GetEventController returns the underlying GtkEventController pointer
+func (c *BaseEventController) GetEventController() *C.GtkEventController {
+	return c.controller
+}
+
+// Native returns the underlying GtkEventController pointer as uintptr
+func (c *BaseEventController) Native() uintptr {
+	return uintptr(unsafe.Pointer(c.controller))
+}
+
+// SetPropagationPhase sets the phase in which the controller handles events
+func (c *BaseEventController) SetPropagationPhase(phase PropagationPhase) {
+	C.gtk_event_controller_set_propagation_phase(c.controller, C.GtkPropagationPhase(phase))
+}
+
+// GetPropagationPhase gets the phase in which the controller handles events
+func (c *BaseEventController) GetPropagationPhase() PropagationPhase {
+	return PropagationPhase(C.gtk_event_controller_get_propagation_phase(c.controller))
+}
+
+// SetName sets a name for the controller, useful for debugging
+func (c *BaseEventController) SetName(name string) {
+	WithCString(name, func(cName *C.char) {
+		C.gtk_event_controller_set_name(c.controller, cName)
+	})
+}
+
+// GetCurrentEventState returns the modifier keys and mouse buttons held down
+// during the event currently being handled. Only meaningful inside a signal
+// callback of the controller.
+func (c *BaseEventController) GetCurrentEventState() ModifierType {
+	return ModifierType(C.gtk_event_controller_get_current_event_state(c.controller))
+}
+
+// Destroy disconnects all signal handlers of the controller
+func (c *BaseEventController) Destroy() {
+	DisconnectAll(c)
+}
+
+// controllerRegistry tracks the controllers added to each widget with
+// AddController, keyed by the widget pointer, so they can be detached when
+// the widget is destroyed
+var controllerRegistry = struct {
+	sync.Mutex
+	controllers map[uintptr][]EventController
+	owners      map[uintptr]uintptr
+}{
+	controllers: make(map[uintptr][]EventController),
+	owners:      make(map[uintptr]uintptr),
+}
+
+// AddController adds an event controller to the widget.
+// The widget takes ownership of the controller. A controller can only belong
+// to one widget, so adding one that is already attached to another widget
+// returns an error; adding it again to the same widget does nothing.
+func (w *BaseWidget) AddController(controller EventController) error {
+	if w.widget == nil {
+		return &GTKError{Op: "AddController", Err: fmt.Errorf("widget has been destroyed")}
+	}
+	cController := controller.GetEventController()
+	if cController == nil {
+		return &GTKError{Op: "AddController", Err: fmt.Errorf("controller has been destroyed")}
+	}
+
+	if owner := C.gtk_event_controller_get_widget(cController); owner != nil {
+		if owner == w.widget {
+			return nil
+		}
+		return &GTKError{Op: "AddController", Err: fmt.Errorf("%T is already attached to another widget", controller)}
+	}
+
+	widgetPtr := uintptr(unsafe.Pointer(w.widget))
+	controllerPtr := uintptr(unsafe.Pointer(cController))
+
+	controllerRegistry.Lock()
+	controllerRegistry.controllers[widgetPtr] = append(controllerRegistry.controllers[widgetPtr], controller)
+	controllerRegistry.owners[controllerPtr] = widgetPtr
+	controllerRegistry.Unlock()
+
+	C.watchController(cController)
+	C.gtk_widget_add_controller(w.widget, cController)
+	return nil
+}
+
+// RemoveController removes an event controller from the widget.
+// The controller's signal handlers are disconnected and the controller is
+// freed, so it must not be used afterwards. Removing a controller that isn't
+// attached to the widget does nothing.
+func (w *BaseWidget) RemoveController(controller EventController) {
+	if w.widget == nil {
+		return
+	}
+	cController := controller.GetEventController()
+	if cController == nil || C.gtk_event_controller_get_widget(cController) != w.widget {
+		return
+	}
+
+	if untrackController(uintptr(unsafe.Pointer(cController))) != nil {
+		C.unwatchController(cController)
+	}
+	detachController(w.widget, controller)
+}
+
+// GetControllers returns the controllers added to the widget with
+// AddController that are still attached
+func (w *BaseWidget) GetControllers() []EventController {
+	if w.widget == nil {
+		return nil
+	}
+
+	controllerRegistry.Lock()
+	defer controllerRegistry.Unlock()
+
+	controllers := controllerRegistry.controllers[uintptr(unsafe.Pointer(w.widget))]
+	return append([]EventController(nil), controllers...)
+}
+
+// detachController disconnects the handlers of a controller and removes it
+// from its widget, which frees it
+func detachController(widget *C.GtkWidget, controller EventController) {
+	DisconnectAll(controller)
+	C.gtk_widget_remove_controller(widget, controller.GetEventController())
+}
+
+// detachControllers removes all tracked controllers from a widget; called
+// when the widget is destroyed
+func detachControllers(widget *C.GtkWidget) {
+	if widget == nil {
+		return
+	}
+
+	widgetPtr := uintptr(unsafe.Pointer(widget))
+
+	controllerRegistry.Lock()
+	controllers := controllerRegistry.controllers[widgetPtr]
+	delete(controllerRegistry.controllers, widgetPtr)
+	for _, controller := range controllers {
+		delete(controllerRegistry.owners, uintptr(unsafe.Pointer(controller.GetEventController())))
+	}
+	controllerRegistry.Unlock()
+
+	// Detach outside the lock; freeing a controller may run GTK code that
+	// calls back into Go
+	for _, controller := range controllers {
+		C.unwatchController(controller.GetEventController())
+		detachController(widget, controller)
+	}
+}
+
+// untrackController removes a controller from the registry and returns it,
+// or nil if it isn't tracked
+func untrackController(controllerPtr uintptr) EventController {
+	controllerRegistry.Lock()
+	defer controllerRegistry.Unlock()
+
+	widgetPtr, ok := controllerRegistry.owners[controllerPtr]
+	if !ok {
+		return nil
+	}
+	delete(controllerRegistry.owners, controllerPtr)
+
+	var removed EventController
+	controllers := controllerRegistry.controllers[widgetPtr]
+	for i, controller := range controllers {
+		if uintptr(unsafe.Pointer(controller.GetEventController())) == controllerPtr {
+			removed = controller
+			controllers = append(controllers[:i], controllers[i+1:]...)
+			break
+		}
+	}
+	if len(controllers) == 0 {
+		delete(controllerRegistry.controllers, widgetPtr)
+	} else {
+		controllerRegistry.controllers[widgetPtr] = controllers
+	}
+	return removed
+}
+
+//export controllerWeakNotify
+func controllerWeakNotify(data C.gpointer, object *C.GObject) {
+	// The widget was freed by GTK without being destroyed from Go, e.g. a
+	// recycled list row; release the handlers of its controllers. The
+	// controller is being disposed but is still valid at this point.
+	if controller := untrackController(uintptr(unsafe.Pointer(object))); controller != nil {
+		DisconnectAll(controller)
+	}
+}
@@ -0,0 +1,148 @@
+// Package gtk4 provides keyboard event controller functionality for GTK4
+// File: gtk4go/gtk4/eventControllerKey.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// extern gboolean keyPressedCallback(GtkEventControllerKey *controller, guint keyval, guint keycode, GdkModifierType state, gpointer user_data);
+// extern void keyReleasedCallback(GtkEventControllerKey *controller, guint keyval, guint keycode, GdkModifierType state, gpointer user_data);
+//
+// static gulong connectKeyPressed(GtkEventControllerKey *controller, guint callbackId) {
+//     return g_signal_connect(controller, "key-pressed", G_CALLBACK(keyPressedCallback), GUINT_TO_POINTER(callbackId));
+// }
+//
+// static gulong connectKeyReleased(GtkEventControllerKey *controller, guint callbackId) {
+//     return g_signal_connect(controller, "key-released", G_CALLBACK(keyReleasedCallback), GUINT_TO_POINTER(callbackId));
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+// Key controller signal types
+const (
+	SignalKeyPressed  SignalType = "key-pressed"
+	SignalKeyReleased SignalType = "key-released"
+)
+
+// Common key values, as passed to key callbacks
+const (
+	KeyReturn    uint = C.GDK_KEY_Return
+	KeyEscape    uint = C.GDK_KEY_Escape
+	KeyTab       uint = C.GDK_KEY_Tab
+	KeySpace     uint = C.GDK_KEY_space
+	KeyBackSpace uint = C.GDK_KEY_BackSpace
+	KeyDelete    uint = C.GDK_KEY_Delete
+	KeyInsert    uint = C.GDK_KEY_Insert
+	KeyHome      uint = C.GDK_KEY_Home
+	KeyEnd       uint = C.GDK_KEY_End
+	KeyPageUp    uint = C.GDK_KEY_Page_Up
+	KeyPageDown  uint = C.GDK_KEY_Page_Down
+	KeyUp        uint = C.GDK_KEY_Up
+	KeyDown      uint = C.GDK_KEY_Down
+	KeyLeft      uint = C.GDK_KEY_Left
+	KeyRight     uint = C.GDK_KEY_Right
+	KeyF1        uint = C.GDK_KEY_F1
+	KeyF5        uint = C.GDK_KEY_F5
+)
+
+// KeyPressedCallback is called when a key is pressed. Returning true stops
+// the event from propagating further.
+type KeyPressedCallback func(keyval, keycode uint, state ModifierType) bool
+
+// KeyReleasedCallback is called when a key is released
+type KeyReleasedCallback func(keyval, keycode uint, state ModifierType)
+
+// KeyvalName returns the name of a key value, such as "Delete" or "a"
+func KeyvalName(keyval uint) string {
+	cName := C.gdk_keyval_name(C.guint(keyval))
+	if cName == nil {
+		return ""
+	}
+	return C.GoString(cName)
+}
+
+// KeyvalToLower converts a key value to lower case, so that shortcuts can
+// be matched regardless of the Shift and Caps Lock state
+func KeyvalToLower(keyval uint) uint {
+	return uint(C.gdk_keyval_to_lower(C.guint(keyval)))
+}
+
+// EventControllerKey handles keyboard input on a widget.
+// The widget (or one of its children) must have keyboard focus to receive
+// key events. Add it to a widget with AddController.
+type EventControllerKey struct {
+	BaseEventController
+}
+
+// NewEventControllerKey creates a new keyboard event controller
+func NewEventControllerKey() *EventControllerKey {
+	return &EventControllerKey{
+		BaseEventController: BaseEventController{
+			controller: C.gtk_event_controller_key_new(),
+		},
+	}
+}
+
+// keyController returns the controller as a GtkEventControllerKey
+func (k *EventControllerKey) keyController() *C.GtkEventControllerKey {
+	return (*C.GtkEventControllerKey)(unsafe.Pointer(k.controller))
+}
+
+// ConnectKeyPressed connects a callback for key presses. The callback runs
+// synchronously on the UI thread; returning true marks the key as handled and
+// stops propagation, returning false lets other handlers see it.
+func (k *EventControllerKey) ConnectKeyPressed(callback KeyPressedCallback) uint64 {
+	return connectCustomSignal(k, SignalKeyPressed, callback, func(id C.guint) C.gulong {
+		return C.connectKeyPressed(k.keyController(), id)
+	})
+}
+
+// ConnectKeyReleased connects a callback for key releases
+func (k *EventControllerKey) ConnectKeyReleased(callback KeyReleasedCallback) uint64 {
+	return connectCustomSignal(k, SignalKeyReleased, callback, func(id C.guint) C.gulong {
+		return C.connectKeyReleased(k.keyController(), id)
+	})
+}
+
+//export keyPressedCallback
+func keyPressedCallback(controller *C.GtkEventControllerKey, keyval, keycode C.guint, state C.GdkModifierType, userData C.gpointer) C.gboolean {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return C.FALSE
+	}
+
+	cb, ok := callback.(KeyPressedCallback)
+	if !ok {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"keyPressedCallback: callback has wrong type: %T", callback)
+		return C.FALSE
+	}
+
+	// Signal handlers run on the UI thread, and the return value is needed
+	// to decide propagation, so the callback is executed directly
+	if cb(uint(keyval), uint(keycode), ModifierType(state)) {
+		return C.TRUE
+	}
+	return C.FALSE
+}
+
+//export keyReleasedCallback
+func keyReleasedCallback(controller *C.GtkEventControllerKey, keyval, keycode C.guint, state C.GdkModifierType, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	if cb, ok := callback.(KeyReleasedCallback); ok {
+		SafeCallback(func() {
+			cb(uint(keyval), uint(keycode), ModifierType(state))
+		})
+	} else {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"keyReleasedCallback: callback has wrong type: %T", callback)
+	}
+}
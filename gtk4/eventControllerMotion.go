@@ -0,0 +1,107 @@
+// Package gtk4 provides pointer motion event controller functionality for GTK4
+// File: gtk4go/gtk4/eventControllerMotion.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// extern void motionPositionCallback(GtkEventControllerMotion *controller, gdouble x, gdouble y, gpointer user_data);
+//
+// static gulong connectMotionPosition(GtkEventControllerMotion *controller, const char *signal, guint callbackId) {
+//     return g_signal_connect(controller, signal, G_CALLBACK(motionPositionCallback), GUINT_TO_POINTER(callbackId));
+// }
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/justyntemme/gtk4go/core/uithread"
+)
+
+// Motion controller signal types
+const (
+	SignalEnter  SignalType = "enter"
+	SignalLeave  SignalType = "leave"
+	SignalMotion SignalType = "motion"
+)
+
+// EventControllerMotion tracks the pointer entering, leaving and moving
+// over a widget. Add it to a widget with AddController.
+type EventControllerMotion struct {
+	BaseEventController
+}
+
+// NewEventControllerMotion creates a new pointer motion event controller
+func NewEventControllerMotion() *EventControllerMotion {
+	return &EventControllerMotion{
+		BaseEventController: BaseEventController{
+			controller: C.gtk_event_controller_motion_new(),
+		},
+	}
+}
+
+// motionController returns the controller as a GtkEventControllerMotion
+func (m *EventControllerMotion) motionController() *C.GtkEventControllerMotion {
+	return (*C.GtkEventControllerMotion)(unsafe.Pointer(m.controller))
+}
+
+// connectPositionSignal connects an enter/motion handler
+func (m *EventControllerMotion) connectPositionSignal(signal SignalType, callback func(x, y float64)) uint64 {
+	return connectCustomSignal(m, signal, callback, func(id C.guint) C.gulong {
+		var handlerID C.gulong
+		WithCString(string(signal), func(cSignal *C.char) {
+			handlerID = C.connectMotionPosition(m.motionController(), cSignal, id)
+		})
+		return handlerID
+	})
+}
+
+// ConnectEnter connects a callback for when the pointer enters the widget.
+// x and y are relative to the widget. GTK pairs every enter with a leave,
+// including when a pointer grab moves the pointer focus elsewhere.
+func (m *EventControllerMotion) ConnectEnter(callback func(x, y float64)) uint64 {
+	return m.connectPositionSignal(SignalEnter, callback)
+}
+
+// ConnectLeave connects a callback for when the pointer leaves the widget
+func (m *EventControllerMotion) ConnectLeave(callback func()) uint64 {
+	return Connect(m, SignalLeave, callback)
+}
+
+// ConnectMotion connects a callback for pointer movement over the widget.
+// x and y are relative to the widget. The callback runs synchronously during
+// the event, so GetCurrentEventState reports the modifiers held down.
+func (m *EventControllerMotion) ConnectMotion(callback func(x, y float64)) uint64 {
+	return m.connectPositionSignal(SignalMotion, callback)
+}
+
+// ContainsPointer returns whether the pointer is over the widget or one of its children
+func (m *EventControllerMotion) ContainsPointer() bool {
+	return C.gtk_event_controller_motion_contains_pointer(m.motionController()) == C.TRUE
+}
+
+// IsPointer returns whether the pointer is over the widget itself rather
+// than one of its children
+func (m *EventControllerMotion) IsPointer() bool {
+	return C.gtk_event_controller_motion_is_pointer(m.motionController()) == C.TRUE
+}
+
+//export motionPositionCallback
+func motionPositionCallback(controller *C.GtkEventControllerMotion, x, y C.gdouble, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	if cb, ok := callback.(func(float64, float64)); ok {
+		// The event is only current during the emission, so the callback
+		// runs directly for GetCurrentEventState to work
+		uithread.Protect(func() {
+			cb(float64(x), float64(y))
+		})
+	} else {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"motionPositionCallback: callback has wrong type: %T", callback)
+	}
+}
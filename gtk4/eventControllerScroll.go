@@ -0,0 +1,125 @@
+// Package gtk4 provides scroll event controller functionality for GTK4
+// File: gtk4go/gtk4/eventControllerScroll.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// extern gboolean scrollCallback(GtkEventControllerScroll *controller, gdouble dx, gdouble dy, gpointer user_data);
+//
+// static gulong connectScroll(GtkEventControllerScroll *controller, guint callbackId) {
+//     return g_signal_connect(controller, "scroll", G_CALLBACK(scrollCallback), GUINT_TO_POINTER(callbackId));
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+// Scroll controller signal types
+const (
+	SignalScroll      SignalType = "scroll"
+	SignalScrollBegin SignalType = "scroll-begin"
+	SignalScrollEnd   SignalType = "scroll-end"
+)
+
+// ScrollFlags controls which scroll events a scroll controller handles and
+// how the deltas are reported
+type ScrollFlags int
+
+const (
+	// ScrollNone handles no scroll events
+	ScrollNone ScrollFlags = C.GTK_EVENT_CONTROLLER_SCROLL_NONE
+	// ScrollVertical handles scrolling on the vertical axis
+	ScrollVertical ScrollFlags = C.GTK_EVENT_CONTROLLER_SCROLL_VERTICAL
+	// ScrollHorizontal handles scrolling on the horizontal axis
+	ScrollHorizontal ScrollFlags = C.GTK_EVENT_CONTROLLER_SCROLL_HORIZONTAL
+	// ScrollBothAxes handles scrolling on both axes
+	ScrollBothAxes ScrollFlags = C.GTK_EVENT_CONTROLLER_SCROLL_BOTH_AXES
+	// ScrollDiscrete reports whole steps instead of smooth deltas, e.g. one
+	// per mouse wheel click; smooth touchpad scrolling is accumulated into steps
+	ScrollDiscrete ScrollFlags = C.GTK_EVENT_CONTROLLER_SCROLL_DISCRETE
+	// ScrollKinetic emits decelerate after a touchpad scroll ends
+	ScrollKinetic ScrollFlags = C.GTK_EVENT_CONTROLLER_SCROLL_KINETIC
+)
+
+// ScrollCallback is called for scroll events with the scroll deltas.
+// Returning true stops the event from propagating further, e.g. so that a
+// parent ScrolledWindow doesn't scroll as well.
+type ScrollCallback func(dx, dy float64) bool
+
+// EventControllerScroll handles mouse wheel and touchpad scrolling on a
+// widget. Add it to a widget with AddController.
+type EventControllerScroll struct {
+	BaseEventController
+}
+
+// NewEventControllerScroll creates a new scroll event controller. flags
+// selects the axes to handle and whether deltas are smooth or discrete, e.g.
+// ScrollVertical|ScrollDiscrete.
+func NewEventControllerScroll(flags ScrollFlags) *EventControllerScroll {
+	return &EventControllerScroll{
+		BaseEventController: BaseEventController{
+			controller: C.gtk_event_controller_scroll_new(C.GtkEventControllerScrollFlags(flags)),
+		},
+	}
+}
+
+// scrollController returns the controller as a GtkEventControllerScroll
+func (s *EventControllerScroll) scrollController() *C.GtkEventControllerScroll {
+	return (*C.GtkEventControllerScroll)(unsafe.Pointer(s.controller))
+}
+
+// SetFlags sets the scroll flags of the controller
+func (s *EventControllerScroll) SetFlags(flags ScrollFlags) {
+	C.gtk_event_controller_scroll_set_flags(s.scrollController(), C.GtkEventControllerScrollFlags(flags))
+}
+
+// GetFlags gets the scroll flags of the controller
+func (s *EventControllerScroll) GetFlags() ScrollFlags {
+	return ScrollFlags(C.gtk_event_controller_scroll_get_flags(s.scrollController()))
+}
+
+// ConnectScroll connects a callback for scroll events. dx and dy are in
+// steps with ScrollDiscrete and in smooth scroll units otherwise; positive dy
+// means scrolling down. Use GetCurrentEventState inside the callback to check
+// for modifiers, e.g. ModifierControl for zooming. The callback runs
+// synchronously on the UI thread; returning true marks the event as handled.
+func (s *EventControllerScroll) ConnectScroll(callback ScrollCallback) uint64 {
+	return connectCustomSignal(s, SignalScroll, callback, func(id C.guint) C.gulong {
+		return C.connectScroll(s.scrollController(), id)
+	})
+}
+
+// ConnectScrollBegin connects a callback for the start of a touchpad scroll
+func (s *EventControllerScroll) ConnectScrollBegin(callback func()) uint64 {
+	return Connect(s, SignalScrollBegin, callback)
+}
+
+// ConnectScrollEnd connects a callback for the end of a touchpad scroll
+func (s *EventControllerScroll) ConnectScrollEnd(callback func()) uint64 {
+	return Connect(s, SignalScrollEnd, callback)
+}
+
+//export scrollCallback
+func scrollCallback(controller *C.GtkEventControllerScroll, dx, dy C.gdouble, userData C.gpointer) C.gboolean {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return C.FALSE
+	}
+
+	cb, ok := callback.(ScrollCallback)
+	if !ok {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"scrollCallback: callback has wrong type: %T", callback)
+		return C.FALSE
+	}
+
+	// Signal handlers run on the UI thread, and the return value is needed
+	// to decide propagation, so the callback is executed directly
+	if cb(float64(dx), float64(dy)) {
+		return C.TRUE
+	}
+	return C.FALSE
+}
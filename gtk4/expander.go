@@ -0,0 +1,148 @@
+// Package gtk4 provides expander functionality for GTK4
+// File: gtk4go/gtk4/expander.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// ExpanderOption is a function that configures an expander
+type ExpanderOption func(*Expander)
+
+// Expander represents a GTK expander that shows or hides its child
+// when the user clicks the label
+type Expander struct {
+	BaseWidget
+}
+
+// NewExpander creates a new GTK expander with the given label
+func NewExpander(label string, options ...ExpanderOption) *Expander {
+	var widget *C.GtkWidget
+
+	WithCString(label, func(cLabel *C.char) {
+		widget = C.gtk_expander_new(cLabel)
+	})
+
+	expander := &Expander{
+		BaseWidget: BaseWidget{
+			widget: widget,
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(expander)
+	}
+
+	SetupFinalization(expander, expander.Destroy)
+	return expander
+}
+
+// WithExpanded sets whether the expander is initially expanded
+func WithExpanded(expanded bool) ExpanderOption {
+	return func(e *Expander) {
+		e.SetExpanded(expanded)
+	}
+}
+
+// WithExpanderChild sets the child widget of the expander
+func WithExpanderChild(child Widget) ExpanderOption {
+	return func(e *Expander) {
+		e.SetChild(child)
+	}
+}
+
+// SetChild sets the child widget that is shown when the expander is expanded
+func (e *Expander) SetChild(child Widget) {
+	if child == nil {
+		C.gtk_expander_set_child((*C.GtkExpander)(unsafe.Pointer(e.widget)), nil)
+		return
+	}
+	C.gtk_expander_set_child((*C.GtkExpander)(unsafe.Pointer(e.widget)), child.GetWidget())
+}
+
+// SetExpanded sets whether the expander is expanded
+func (e *Expander) SetExpanded(expanded bool) {
+	var cExpanded C.gboolean
+	if expanded {
+		cExpanded = C.TRUE
+	} else {
+		cExpanded = C.FALSE
+	}
+	C.gtk_expander_set_expanded((*C.GtkExpander)(unsafe.Pointer(e.widget)), cExpanded)
+}
+
+// GetExpanded gets whether the expander is expanded
+func (e *Expander) GetExpanded() bool {
+	return C.gtk_expander_get_expanded((*C.GtkExpander)(unsafe.Pointer(e.widget))) == C.TRUE
+}
+
+// SetLabel sets the text of the expander's label
+func (e *Expander) SetLabel(label string) {
+	WithCString(label, func(cLabel *C.char) {
+		C.gtk_expander_set_label((*C.GtkExpander)(unsafe.Pointer(e.widget)), cLabel)
+	})
+}
+
+// GetLabel gets the text of the expander's label
+func (e *Expander) GetLabel() string {
+	cLabel := C.gtk_expander_get_label((*C.GtkExpander)(unsafe.Pointer(e.widget)))
+	if cLabel == nil {
+		return ""
+	}
+	return C.GoString(cLabel)
+}
+
+// SetLabelWidget sets a custom widget to use as the expander's label
+func (e *Expander) SetLabelWidget(labelWidget Widget) {
+	if labelWidget == nil {
+		C.gtk_expander_set_label_widget((*C.GtkExpander)(unsafe.Pointer(e.widget)), nil)
+		return
+	}
+	C.gtk_expander_set_label_widget((*C.GtkExpander)(unsafe.Pointer(e.widget)), labelWidget.GetWidget())
+}
+
+// SetUseMarkup sets whether the label text is parsed as Pango markup
+func (e *Expander) SetUseMarkup(useMarkup bool) {
+	var cUseMarkup C.gboolean
+	if useMarkup {
+		cUseMarkup = C.TRUE
+	} else {
+		cUseMarkup = C.FALSE
+	}
+	C.gtk_expander_set_use_markup((*C.GtkExpander)(unsafe.Pointer(e.widget)), cUseMarkup)
+}
+
+// SetResizeToplevel sets whether the expander resizes the toplevel window
+// when it is expanded or collapsed
+func (e *Expander) SetResizeToplevel(resizeToplevel bool) {
+	var cResizeToplevel C.gboolean
+	if resizeToplevel {
+		cResizeToplevel = C.TRUE
+	} else {
+		cResizeToplevel = C.FALSE
+	}
+	C.gtk_expander_set_resize_toplevel((*C.GtkExpander)(unsafe.Pointer(e.widget)), cResizeToplevel)
+}
+
+// ConnectNotifyExpanded connects a callback that is called with the new
+// expanded state whenever the expander is expanded or collapsed
+func (e *Expander) ConnectNotifyExpanded(callback func(expanded bool)) uint64 {
+	return ConnectNotify(e, "expanded", func() {
+		callback(e.GetExpanded())
+	})
+}
+
+// Destroy destroys the expander and cleans up resources
+func (e *Expander) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(e)
+
+	// Call base destroy method
+	e.BaseWidget.Destroy()
+}
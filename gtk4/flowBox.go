@@ -0,0 +1,217 @@
+// Package gtk4 provides flow box layout functionality for GTK4
+// File: gtk4go/gtk4/flowBox.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// extern void flowBoxChildActivatedCallback(GtkFlowBox *box, GtkFlowBoxChild *child, gpointer user_data);
+//
+// static gulong connectFlowBoxChildActivated(GtkFlowBox *box, guint callbackId) {
+//     return g_signal_connect(box, "child-activated", G_CALLBACK(flowBoxChildActivatedCallback), GUINT_TO_POINTER(callbackId));
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+// SignalChildActivated is emitted when a child of a flow box is activated
+const SignalChildActivated SignalType = "child-activated"
+
+// SelectionMode defines how many children of a container can be selected
+type SelectionMode int
+
+const (
+	// SelectionNone allows no selection
+	SelectionNone SelectionMode = C.GTK_SELECTION_NONE
+	// SelectionSingle allows at most one selected child
+	SelectionSingle SelectionMode = C.GTK_SELECTION_SINGLE
+	// SelectionBrowse keeps exactly one child selected once the user selected one
+	SelectionBrowse SelectionMode = C.GTK_SELECTION_BROWSE
+	// SelectionMultiple allows any number of selected children
+	SelectionMultiple SelectionMode = C.GTK_SELECTION_MULTIPLE
+)
+
+// FlowBoxOption is a function that configures a flow box
+type FlowBoxOption func(*FlowBox)
+
+// FlowBox represents a GTK flow box, which places its children in rows and
+// reflows them into more or fewer columns as its width changes. Put it in a
+// ScrolledWindow when the children may not fit vertically.
+type FlowBox struct {
+	BaseWidget
+}
+
+// NewFlowBox creates a new GTK flow box
+func NewFlowBox(options ...FlowBoxOption) *FlowBox {
+	flowBox := &FlowBox{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_flow_box_new(),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(flowBox)
+	}
+
+	SetupFinalization(flowBox, flowBox.Destroy)
+	return flowBox
+}
+
+// WithMaxChildrenPerLine sets the maximum number of children per line
+func WithMaxChildrenPerLine(n int) FlowBoxOption {
+	return func(fb *FlowBox) {
+		fb.SetMaxChildrenPerLine(n)
+	}
+}
+
+// WithMinChildrenPerLine sets the minimum number of children per line
+func WithMinChildrenPerLine(n int) FlowBoxOption {
+	return func(fb *FlowBox) {
+		fb.SetMinChildrenPerLine(n)
+	}
+}
+
+// WithFlowBoxSelectionMode sets the selection mode of the flow box
+func WithFlowBoxSelectionMode(mode SelectionMode) FlowBoxOption {
+	return func(fb *FlowBox) {
+		fb.SetSelectionMode(mode)
+	}
+}
+
+// WithFlowBoxHomogeneous sets whether all children get the same size
+func WithFlowBoxHomogeneous(homogeneous bool) FlowBoxOption {
+	return func(fb *FlowBox) {
+		fb.SetHomogeneous(homogeneous)
+	}
+}
+
+// WithFlowBoxSpacing sets the spacing between rows and between columns
+func WithFlowBoxSpacing(rowSpacing, columnSpacing int) FlowBoxOption {
+	return func(fb *FlowBox) {
+		fb.SetRowSpacing(rowSpacing)
+		fb.SetColumnSpacing(columnSpacing)
+	}
+}
+
+// flowBox returns the widget as a GtkFlowBox
+func (fb *FlowBox) flowBox() *C.GtkFlowBox {
+	return (*C.GtkFlowBox)(unsafe.Pointer(fb.widget))
+}
+
+// Append adds a child at the end of the flow box
+func (fb *FlowBox) Append(child Widget) {
+	C.gtk_flow_box_append(fb.flowBox(), child.GetWidget())
+}
+
+// Insert adds a child at the given position; -1 appends it
+func (fb *FlowBox) Insert(child Widget, position int) {
+	C.gtk_flow_box_insert(fb.flowBox(), child.GetWidget(), C.int(position))
+}
+
+// Remove removes a child from the flow box
+func (fb *FlowBox) Remove(child Widget) {
+	C.gtk_flow_box_remove(fb.flowBox(), child.GetWidget())
+}
+
+// SetMaxChildrenPerLine sets the maximum number of children per line
+func (fb *FlowBox) SetMaxChildrenPerLine(n int) {
+	C.gtk_flow_box_set_max_children_per_line(fb.flowBox(), C.guint(n))
+}
+
+// GetMaxChildrenPerLine returns the maximum number of children per line
+func (fb *FlowBox) GetMaxChildrenPerLine() int {
+	return int(C.gtk_flow_box_get_max_children_per_line(fb.flowBox()))
+}
+
+// SetMinChildrenPerLine sets the minimum number of children per line
+func (fb *FlowBox) SetMinChildrenPerLine(n int) {
+	C.gtk_flow_box_set_min_children_per_line(fb.flowBox(), C.guint(n))
+}
+
+// GetMinChildrenPerLine returns the minimum number of children per line
+func (fb *FlowBox) GetMinChildrenPerLine() int {
+	return int(C.gtk_flow_box_get_min_children_per_line(fb.flowBox()))
+}
+
+// SetSelectionMode sets how many children can be selected
+func (fb *FlowBox) SetSelectionMode(mode SelectionMode) {
+	C.gtk_flow_box_set_selection_mode(fb.flowBox(), C.GtkSelectionMode(mode))
+}
+
+// GetSelectionMode returns how many children can be selected
+func (fb *FlowBox) GetSelectionMode() SelectionMode {
+	return SelectionMode(C.gtk_flow_box_get_selection_mode(fb.flowBox()))
+}
+
+// SetHomogeneous sets whether all children get the same size
+func (fb *FlowBox) SetHomogeneous(homogeneous bool) {
+	var chomogeneous C.gboolean
+	if homogeneous {
+		chomogeneous = C.TRUE
+	} else {
+		chomogeneous = C.FALSE
+	}
+	C.gtk_flow_box_set_homogeneous(fb.flowBox(), chomogeneous)
+}
+
+// GetHomogeneous returns whether all children get the same size
+func (fb *FlowBox) GetHomogeneous() bool {
+	return C.gtk_flow_box_get_homogeneous(fb.flowBox()) == C.TRUE
+}
+
+// SetRowSpacing sets the spacing between rows
+func (fb *FlowBox) SetRowSpacing(spacing int) {
+	C.gtk_flow_box_set_row_spacing(fb.flowBox(), C.guint(spacing))
+}
+
+// SetColumnSpacing sets the spacing between columns
+func (fb *FlowBox) SetColumnSpacing(spacing int) {
+	C.gtk_flow_box_set_column_spacing(fb.flowBox(), C.guint(spacing))
+}
+
+// SetActivateOnSingleClick sets whether children are activated on a single click
+func (fb *FlowBox) SetActivateOnSingleClick(single bool) {
+	var csingle C.gboolean
+	if single {
+		csingle = C.TRUE
+	} else {
+		csingle = C.FALSE
+	}
+	C.gtk_flow_box_set_activate_on_single_click(fb.flowBox(), csingle)
+}
+
+// ConnectChildActivated connects a callback for the activation of a child.
+// The callback receives the index of the child in the flow box.
+func (fb *FlowBox) ConnectChildActivated(callback func(index int)) uint64 {
+	return connectCustomSignal(fb, SignalChildActivated, callback, func(id C.guint) C.gulong {
+		return C.connectFlowBoxChildActivated(fb.flowBox(), id)
+	})
+}
+
+// Destroy destroys the flow box and cleans up resources
+func (fb *FlowBox) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(fb)
+
+	// Call base destroy method
+	fb.BaseWidget.Destroy()
+}
+
+//export flowBoxChildActivatedCallback
+func flowBoxChildActivatedCallback(box *C.GtkFlowBox, child *C.GtkFlowBoxChild, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	if cb, ok := callback.(func(int)); ok {
+		SafeCallback(cb, int(C.gtk_flow_box_child_get_index(child)))
+	} else {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"flowBoxChildActivatedCallback: callback has wrong type: %T", callback)
+	}
+}
@@ -0,0 +1,219 @@
+// Package gtk4 provides frame functionality for GTK4
+// File: gtk4go/gtk4/frame.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// FrameOption is a function that configures a frame
+type FrameOption func(*Frame)
+
+// Frame represents a GTK frame that draws a titled border around its child
+type Frame struct {
+	BaseWidget
+}
+
+// NewFrame creates a new GTK frame with the given label.
+// An empty label creates an unlabeled frame.
+func NewFrame(label string, options ...FrameOption) *Frame {
+	var widget *C.GtkWidget
+
+	if label == "" {
+		widget = C.gtk_frame_new(nil)
+	} else {
+		WithCString(label, func(cLabel *C.char) {
+			widget = C.gtk_frame_new(cLabel)
+		})
+	}
+
+	frame := &Frame{
+		BaseWidget: BaseWidget{
+			widget: widget,
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(frame)
+	}
+
+	SetupFinalization(frame, frame.Destroy)
+	return frame
+}
+
+// WithFrameChild sets the child widget of the frame
+func WithFrameChild(child Widget) FrameOption {
+	return func(f *Frame) {
+		f.SetChild(child)
+	}
+}
+
+// WithLabelAlign sets the horizontal alignment of the frame's label
+func WithLabelAlign(xalign float64) FrameOption {
+	return func(f *Frame) {
+		f.SetLabelAlign(xalign)
+	}
+}
+
+// SetChild sets the child widget of the frame
+func (f *Frame) SetChild(child Widget) {
+	if child == nil {
+		C.gtk_frame_set_child((*C.GtkFrame)(unsafe.Pointer(f.widget)), nil)
+		return
+	}
+	C.gtk_frame_set_child((*C.GtkFrame)(unsafe.Pointer(f.widget)), child.GetWidget())
+}
+
+// SetLabel sets the text of the frame's label. An empty string removes the label.
+func (f *Frame) SetLabel(label string) {
+	if label == "" {
+		C.gtk_frame_set_label((*C.GtkFrame)(unsafe.Pointer(f.widget)), nil)
+		return
+	}
+
+	WithCString(label, func(cLabel *C.char) {
+		C.gtk_frame_set_label((*C.GtkFrame)(unsafe.Pointer(f.widget)), cLabel)
+	})
+}
+
+// GetLabel gets the text of the frame's label
+func (f *Frame) GetLabel() string {
+	cLabel := C.gtk_frame_get_label((*C.GtkFrame)(unsafe.Pointer(f.widget)))
+	if cLabel == nil {
+		return ""
+	}
+	return C.GoString(cLabel)
+}
+
+// SetLabelWidget sets a custom widget to use as the frame's label
+func (f *Frame) SetLabelWidget(labelWidget Widget) {
+	if labelWidget == nil {
+		C.gtk_frame_set_label_widget((*C.GtkFrame)(unsafe.Pointer(f.widget)), nil)
+		return
+	}
+	C.gtk_frame_set_label_widget((*C.GtkFrame)(unsafe.Pointer(f.widget)), labelWidget.GetWidget())
+}
+
+// SetLabelAlign sets the horizontal alignment of the label,
+// from 0.0 (start) to 1.0 (end)
+func (f *Frame) SetLabelAlign(xalign float64) {
+	C.gtk_frame_set_label_align((*C.GtkFrame)(unsafe.Pointer(f.widget)), C.float(xalign))
+}
+
+// GetLabelAlign gets the horizontal alignment of the label
+func (f *Frame) GetLabelAlign() float64 {
+	return float64(C.gtk_frame_get_label_align((*C.GtkFrame)(unsafe.Pointer(f.widget))))
+}
+
+// Destroy destroys the frame and cleans up resources
+func (f *Frame) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(f)
+
+	// Call base destroy method
+	f.BaseWidget.Destroy()
+}
+
+// AspectFrameOption is a function that configures an aspect frame
+type AspectFrameOption func(*AspectFrame)
+
+// AspectFrame represents a GTK aspect frame that keeps its child at a fixed
+// aspect ratio. The ratio is enforced during layout, so it also holds while
+// the window is being resized.
+type AspectFrame struct {
+	BaseWidget
+}
+
+// NewAspectFrame creates a new aspect frame with the given width/height ratio.
+// If obeyChild is true, the ratio is taken from the child's requisition instead.
+// The child is centered within the available space.
+func NewAspectFrame(ratio float64, obeyChild bool, options ...AspectFrameOption) *AspectFrame {
+	var cObeyChild C.gboolean
+	if obeyChild {
+		cObeyChild = C.TRUE
+	} else {
+		cObeyChild = C.FALSE
+	}
+
+	aspectFrame := &AspectFrame{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_aspect_frame_new(0.5, 0.5, C.float(ratio), cObeyChild),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(aspectFrame)
+	}
+
+	SetupFinalization(aspectFrame, aspectFrame.Destroy)
+	return aspectFrame
+}
+
+// WithAspectFrameAlign sets the alignment of the child within the aspect frame
+func WithAspectFrameAlign(xalign, yalign float64) AspectFrameOption {
+	return func(af *AspectFrame) {
+		af.SetXAlign(xalign)
+		af.SetYAlign(yalign)
+	}
+}
+
+// SetChild sets the child widget of the aspect frame
+func (af *AspectFrame) SetChild(child Widget) {
+	if child == nil {
+		C.gtk_aspect_frame_set_child((*C.GtkAspectFrame)(unsafe.Pointer(af.widget)), nil)
+		return
+	}
+	C.gtk_aspect_frame_set_child((*C.GtkAspectFrame)(unsafe.Pointer(af.widget)), child.GetWidget())
+}
+
+// SetRatio sets the width/height ratio of the aspect frame
+func (af *AspectFrame) SetRatio(ratio float64) {
+	C.gtk_aspect_frame_set_ratio((*C.GtkAspectFrame)(unsafe.Pointer(af.widget)), C.float(ratio))
+}
+
+// GetRatio gets the width/height ratio of the aspect frame
+func (af *AspectFrame) GetRatio() float64 {
+	return float64(C.gtk_aspect_frame_get_ratio((*C.GtkAspectFrame)(unsafe.Pointer(af.widget))))
+}
+
+// SetObeyChild sets whether the ratio is taken from the child's requisition
+func (af *AspectFrame) SetObeyChild(obeyChild bool) {
+	var cObeyChild C.gboolean
+	if obeyChild {
+		cObeyChild = C.TRUE
+	} else {
+		cObeyChild = C.FALSE
+	}
+	C.gtk_aspect_frame_set_obey_child((*C.GtkAspectFrame)(unsafe.Pointer(af.widget)), cObeyChild)
+}
+
+// GetObeyChild gets whether the ratio is taken from the child's requisition
+func (af *AspectFrame) GetObeyChild() bool {
+	return C.gtk_aspect_frame_get_obey_child((*C.GtkAspectFrame)(unsafe.Pointer(af.widget))) == C.TRUE
+}
+
+// SetXAlign sets the horizontal alignment of the child, from 0.0 to 1.0
+func (af *AspectFrame) SetXAlign(xalign float64) {
+	C.gtk_aspect_frame_set_xalign((*C.GtkAspectFrame)(unsafe.Pointer(af.widget)), C.float(xalign))
+}
+
+// SetYAlign sets the vertical alignment of the child, from 0.0 to 1.0
+func (af *AspectFrame) SetYAlign(yalign float64) {
+	C.gtk_aspect_frame_set_yalign((*C.GtkAspectFrame)(unsafe.Pointer(af.widget)), C.float(yalign))
+}
+
+// Destroy destroys the aspect frame and cleans up resources
+func (af *AspectFrame) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(af)
+
+	// Call base destroy method
+	af.BaseWidget.Destroy()
+}
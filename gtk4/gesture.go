@@ -0,0 +1,328 @@
+// Package gtk4 provides gesture functionality for GTK4
+// File: gtk4go/gtk4/gesture.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// extern void gestureClickCallback(GtkGestureClick *gesture, gint n_press, gdouble x, gdouble y, gpointer user_data);
+//
+// static gulong connectGestureClick(GtkGestureClick *gesture, const char *signal, guint callbackId) {
+//     return g_signal_connect(gesture, signal, G_CALLBACK(gestureClickCallback), GUINT_TO_POINTER(callbackId));
+// }
+//
+// extern void gesturePointCallback(GtkGesture *gesture, gdouble x, gdouble y, gpointer user_data);
+//
+// // Connects drag-begin/update/end and long-press pressed, which all pass a
+// // pair of coordinates
+// static gulong connectGesturePoint(GtkGesture *gesture, const char *signal, guint callbackId) {
+//     return g_signal_connect(gesture, signal, G_CALLBACK(gesturePointCallback), GUINT_TO_POINTER(callbackId));
+// }
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/justyntemme/gtk4go/core/uithread"
+)
+
+// Gesture signal types
+const (
+	SignalPressed  SignalType = "pressed"
+	SignalReleased SignalType = "released"
+	SignalStopped  SignalType = "stopped"
+
+	SignalDragBegin  SignalType = "drag-begin"
+	SignalDragUpdate SignalType = "drag-update"
+	SignalDragEnd    SignalType = "drag-end"
+	SignalCancelled  SignalType = "cancelled"
+)
+
+// BaseGesture provides common functionality for single-touch/button gestures
+type BaseGesture struct {
+	BaseEventController
+}
+
+// gestureSingle returns the controller as a GtkGestureSingle
+func (g *BaseGesture) gestureSingle() *C.GtkGestureSingle {
+	return (*C.GtkGestureSingle)(unsafe.Pointer(g.controller))
+}
+
+// SetButton restricts the gesture to a mouse button, e.g. ButtonSecondary
+// for right-clicks. ButtonAny (0) makes the gesture respond to any button.
+func (g *BaseGesture) SetButton(button int) {
+	C.gtk_gesture_single_set_button(g.gestureSingle(), C.guint(button))
+}
+
+// GetButton gets the mouse button the gesture responds to, or ButtonAny
+func (g *BaseGesture) GetButton() int {
+	return int(C.gtk_gesture_single_get_button(g.gestureSingle()))
+}
+
+// GetCurrentButton returns the mouse button that triggered the gesture
+// currently being handled, or 0 if there is none
+func (g *BaseGesture) GetCurrentButton() int {
+	return int(C.gtk_gesture_single_get_current_button(g.gestureSingle()))
+}
+
+// SetTouchOnly sets whether the gesture only responds to touch events
+func (g *BaseGesture) SetTouchOnly(touchOnly bool) {
+	var cTouchOnly C.gboolean
+	if touchOnly {
+		cTouchOnly = C.TRUE
+	} else {
+		cTouchOnly = C.FALSE
+	}
+	C.gtk_gesture_single_set_touch_only(g.gestureSingle(), cTouchOnly)
+}
+
+// GestureClickOption is a function that configures a click gesture
+type GestureClickOption func(*GestureClick)
+
+// GestureClick recognizes single and multiple presses of a mouse button or touch
+type GestureClick struct {
+	BaseGesture
+}
+
+// NewGestureClick creates a new click gesture. Add it to a widget with AddController.
+// By default it only responds to the primary mouse button.
+func NewGestureClick(options ...GestureClickOption) *GestureClick {
+	gesture := &GestureClick{
+		BaseGesture: BaseGesture{
+			BaseEventController: BaseEventController{
+				controller: (*C.GtkEventController)(unsafe.Pointer(C.gtk_gesture_click_new())),
+			},
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(gesture)
+	}
+
+	return gesture
+}
+
+// WithButton restricts the click gesture to a mouse button; ButtonAny means any button
+func WithButton(button int) GestureClickOption {
+	return func(g *GestureClick) {
+		g.SetButton(button)
+	}
+}
+
+// connectClickSignal connects a pressed/released handler
+func (g *GestureClick) connectClickSignal(signal SignalType, callback func(nPress int, x, y float64)) uint64 {
+	return connectCustomSignal(g, signal, callback, func(id C.guint) C.gulong {
+		var handlerID C.gulong
+		WithCString(string(signal), func(cSignal *C.char) {
+			handlerID = C.connectGestureClick((*C.GtkGestureClick)(unsafe.Pointer(g.controller)), cSignal, id)
+		})
+		return handlerID
+	})
+}
+
+// ConnectPressed connects a callback for button presses. nPress is the number
+// of presses in a row (2 for a double-click), and x, y are widget coordinates.
+// The callback runs synchronously during the event, so GetCurrentButton and
+// GetCurrentEventState can be used inside it to find out which button and
+// modifier keys were pressed, e.g. to detect right-clicks with ButtonAny.
+func (g *GestureClick) ConnectPressed(callback func(nPress int, x, y float64)) uint64 {
+	return g.connectClickSignal(SignalPressed, callback)
+}
+
+// ConnectReleased connects a callback for button releases after a press was
+// recognized. It only fires for the button configured with SetButton.
+func (g *GestureClick) ConnectReleased(callback func(nPress int, x, y float64)) uint64 {
+	return g.connectClickSignal(SignalReleased, callback)
+}
+
+// ConnectStopped connects a callback for when a press sequence is interrupted
+// or takes too long to be part of a multi-press
+func (g *GestureClick) ConnectStopped(callback func()) uint64 {
+	return Connect(g, SignalStopped, callback)
+}
+
+// connectPointSignal connects a handler for a signal with x, y arguments
+func (g *BaseGesture) connectPointSignal(signal SignalType, callback func(x, y float64)) uint64 {
+	return connectCustomSignal(g, signal, callback, func(id C.guint) C.gulong {
+		var handlerID C.gulong
+		WithCString(string(signal), func(cSignal *C.char) {
+			handlerID = C.connectGesturePoint((*C.GtkGesture)(unsafe.Pointer(g.controller)), cSignal, id)
+		})
+		return handlerID
+	})
+}
+
+// GestureDragOption is a function that configures a drag gesture
+type GestureDragOption func(*GestureDrag)
+
+// GestureDrag recognizes dragging with a mouse button or touch, e.g. for
+// moving panel handles or drawing
+type GestureDrag struct {
+	BaseGesture
+}
+
+// NewGestureDrag creates a new drag gesture. Add it to a widget with AddController.
+// By default it only responds to the primary mouse button.
+func NewGestureDrag(options ...GestureDragOption) *GestureDrag {
+	gesture := &GestureDrag{
+		BaseGesture: BaseGesture{
+			BaseEventController: BaseEventController{
+				controller: (*C.GtkEventController)(unsafe.Pointer(C.gtk_gesture_drag_new())),
+			},
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(gesture)
+	}
+
+	return gesture
+}
+
+// WithDragButton restricts the drag gesture to a mouse button; ButtonAny means any button
+func WithDragButton(button int) GestureDragOption {
+	return func(g *GestureDrag) {
+		g.SetButton(button)
+	}
+}
+
+// gestureDrag returns the controller as a GtkGestureDrag
+func (g *GestureDrag) gestureDrag() *C.GtkGestureDrag {
+	return (*C.GtkGestureDrag)(unsafe.Pointer(g.controller))
+}
+
+// ConnectDragBegin connects a callback for the start of a drag. x, y is the
+// start point in widget coordinates. The drag callbacks run synchronously
+// during the event, so GetCurrentButton and GetCurrentEventState work in them.
+func (g *GestureDrag) ConnectDragBegin(callback func(x, y float64)) uint64 {
+	return g.connectPointSignal(SignalDragBegin, callback)
+}
+
+// ConnectDragUpdate connects a callback for pointer movement during a drag.
+// x, y is the offset from the start point passed to ConnectDragBegin, not a
+// widget position; add both to get the current point.
+func (g *GestureDrag) ConnectDragUpdate(callback func(x, y float64)) uint64 {
+	return g.connectPointSignal(SignalDragUpdate, callback)
+}
+
+// ConnectDragEnd connects a callback for the end of a drag. x, y is the final
+// offset from the start point, as in ConnectDragUpdate.
+func (g *GestureDrag) ConnectDragEnd(callback func(x, y float64)) uint64 {
+	return g.connectPointSignal(SignalDragEnd, callback)
+}
+
+// GetStartPoint returns the start point of the current drag in widget
+// coordinates. ok is false if no drag is in progress.
+func (g *GestureDrag) GetStartPoint() (x, y float64, ok bool) {
+	var cx, cy C.gdouble
+	ok = C.gtk_gesture_drag_get_start_point(g.gestureDrag(), &cx, &cy) == C.TRUE
+	return float64(cx), float64(cy), ok
+}
+
+// GetOffset returns the offset of the current drag from its start point.
+// ok is false if no drag is in progress.
+func (g *GestureDrag) GetOffset() (x, y float64, ok bool) {
+	var cx, cy C.gdouble
+	ok = C.gtk_gesture_drag_get_offset(g.gestureDrag(), &cx, &cy) == C.TRUE
+	return float64(cx), float64(cy), ok
+}
+
+// GestureLongPressOption is a function that configures a long press gesture
+type GestureLongPressOption func(*GestureLongPress)
+
+// GestureLongPress recognizes a press that is held without moving, the
+// touch equivalent of a right-click
+type GestureLongPress struct {
+	BaseGesture
+}
+
+// NewGestureLongPress creates a new long press gesture. Add it to a widget
+// with AddController. By default it only responds to the primary mouse button.
+func NewGestureLongPress(options ...GestureLongPressOption) *GestureLongPress {
+	gesture := &GestureLongPress{
+		BaseGesture: BaseGesture{
+			BaseEventController: BaseEventController{
+				controller: (*C.GtkEventController)(unsafe.Pointer(C.gtk_gesture_long_press_new())),
+			},
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(gesture)
+	}
+
+	return gesture
+}
+
+// WithDelayFactor scales the time a press must be held, relative to the
+// system long press time
+func WithDelayFactor(factor float64) GestureLongPressOption {
+	return func(g *GestureLongPress) {
+		g.SetDelayFactor(factor)
+	}
+}
+
+// SetDelayFactor scales the time a press must be held, relative to the
+// system long press time; 1 keeps the default, 2 doubles it
+func (g *GestureLongPress) SetDelayFactor(factor float64) {
+	C.gtk_gesture_long_press_set_delay_factor((*C.GtkGestureLongPress)(unsafe.Pointer(g.controller)), C.double(factor))
+}
+
+// GetDelayFactor gets the factor set with SetDelayFactor
+func (g *GestureLongPress) GetDelayFactor() float64 {
+	return float64(C.gtk_gesture_long_press_get_delay_factor((*C.GtkGestureLongPress)(unsafe.Pointer(g.controller))))
+}
+
+// ConnectPressed connects a callback for when a press has been held long
+// enough. x, y is the press position in widget coordinates.
+func (g *GestureLongPress) ConnectPressed(callback func(x, y float64)) uint64 {
+	return g.connectPointSignal(SignalPressed, callback)
+}
+
+// ConnectCancelled connects a callback for when a press is released or moved
+// before it has been held long enough
+func (g *GestureLongPress) ConnectCancelled(callback func()) uint64 {
+	return Connect(g, SignalCancelled, callback)
+}
+
+//export gestureClickCallback
+func gestureClickCallback(gesture *C.GtkGestureClick, nPress C.gint, x, y C.gdouble, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	if cb, ok := callback.(func(int, float64, float64)); ok {
+		// The event is only current during the emission, so the callback
+		// runs directly for GetCurrentButton and GetCurrentEventState to work
+		uithread.Protect(func() {
+			cb(int(nPress), float64(x), float64(y))
+		})
+	} else {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"gestureClickCallback: callback has wrong type: %T", callback)
+	}
+}
+
+//export gesturePointCallback
+func gesturePointCallback(gesture *C.GtkGesture, x, y C.gdouble, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	if cb, ok := callback.(func(float64, float64)); ok {
+		// The event is only current during the emission, so the callback
+		// runs directly for GetCurrentButton and GetCurrentEventState to work
+		uithread.Protect(func() {
+			cb(float64(x), float64(y))
+		})
+	} else {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"gesturePointCallback: callback has wrong type: %T", callback)
+	}
+}
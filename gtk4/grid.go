@@ -110,34 +110,96 @@ func (g *Grid) InsertColumn(position int) {
 	C.gtk_grid_insert_column((*C.GtkGrid)(unsafe.Pointer(g.widget)), C.int(position))
 }
 
-// RemoveRow removes a row from the grid
+// RemoveRow removes a row from the grid. Children that only occupy this row
+// are removed along with their Go-side callbacks and data; children spanning
+// it shrink by one row, and the rows below move up.
 func (g *Grid) RemoveRow(position int) {
+	g.releaseLine(position, false)
 	C.gtk_grid_remove_row((*C.GtkGrid)(unsafe.Pointer(g.widget)), C.int(position))
 }
 
-// RemoveColumn removes a column from the grid
+// RemoveColumn removes a column from the grid. Children that only occupy this
+// column are removed along with their Go-side callbacks and data; children
+// spanning it shrink by one column, and the columns after it move left.
 func (g *Grid) RemoveColumn(position int) {
+	g.releaseLine(position, true)
 	C.gtk_grid_remove_column((*C.GtkGrid)(unsafe.Pointer(g.widget)), C.int(position))
 }
 
-// GetChildAt gets the child at the specified position
+// releaseLine releases the children that GTK will remove together with the
+// given row or column
+func (g *Grid) releaseLine(position int, column bool) {
+	grid := (*C.GtkGrid)(unsafe.Pointer(g.widget))
+	for child := C.gtk_widget_get_first_child(g.widget); child != nil; child = C.gtk_widget_get_next_sibling(child) {
+		var cColumn, cRow, cWidth, cHeight C.int
+		C.gtk_grid_query_child(grid, child, &cColumn, &cRow, &cWidth, &cHeight)
+
+		start, span := cRow, cHeight
+		if column {
+			start, span = cColumn, cWidth
+		}
+		if int(start) == position && span == 1 {
+			releaseWidgetTree(child)
+		}
+	}
+}
+
+// Remove removes a child from the grid. Signal callbacks and data (see
+// SetData) of the child and its descendants are released, so the child's
+// wrapper must not be used afterwards. Widgets that aren't children of the
+// grid are ignored.
+func (g *Grid) Remove(child Widget) {
+	if child == nil || child.GetWidget() == nil {
+		return
+	}
+	if C.gtk_widget_get_parent(child.GetWidget()) != g.widget {
+		return
+	}
+
+	releaseWidgetTree(child.GetWidget())
+	C.gtk_grid_remove((*C.GtkGrid)(unsafe.Pointer(g.widget)), child.GetWidget())
+}
+
+// GetChildAt returns the child covering the given cell, or nil if the cell
+// is empty. The returned widget is a lightweight wrapper, which stops
+// referring to the child once the child is finalized.
 func (g *Grid) GetChildAt(column, row int) Widget {
 	widget := C.gtk_grid_get_child_at(
 		(*C.GtkGrid)(unsafe.Pointer(g.widget)),
 		C.int(column),
 		C.int(row),
 	)
-
-	// We can't directly return a Widget from the C pointer
-	// Instead, we would need to wrap it in an appropriate Go struct
-	// This is a simplified implementation that returns nil
 	if widget == nil {
 		return nil
 	}
+	wrapper := &BaseWidget{widget: widget}
+	trackWrapper(wrapper)
+	return wrapper
+}
+
+// GetChildPosition gets the position and span of a child of the grid.
+// ok is false if the widget is not a child of the grid.
+func (g *Grid) GetChildPosition(child Widget) (column, row, width, height int, ok bool) {
+	if child == nil || child.GetWidget() == nil {
+		return 0, 0, 0, 0, false
+	}
+
+	// gtk_grid_query_child requires the widget to be a direct child
+	if C.gtk_widget_get_parent(child.GetWidget()) != g.widget {
+		return 0, 0, 0, 0, false
+	}
+
+	var cColumn, cRow, cWidth, cHeight C.int
+	C.gtk_grid_query_child(
+		(*C.GtkGrid)(unsafe.Pointer(g.widget)),
+		child.GetWidget(),
+		&cColumn,
+		&cRow,
+		&cWidth,
+		&cHeight,
+	)
 
-	// In a real implementation, we would determine the type of widget
-	// and return an appropriate Go wrapper
-	return nil
+	return int(cColumn), int(cRow), int(cWidth), int(cHeight), true
 }
 
 // SetRowSpacing sets the amount of space between rows
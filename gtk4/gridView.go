@@ -0,0 +1,209 @@
+// Package gtk4 provides grid view functionality for GTK4
+// File: gtk4go/gtk4/gridView.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// // gtk_grid_view_new takes ownership of the model and factory
+// static GtkWidget* createGridView(GtkSelectionModel *model, GtkListItemFactory *factory) {
+//     if (model != NULL) {
+//         g_object_ref(model);
+//     }
+//     if (factory != NULL) {
+//         g_object_ref(factory);
+//     }
+//     return gtk_grid_view_new(model, factory);
+// }
+//
+// // New in GTK 4.12: Scroll to API
+// static void gridViewScrollTo(GtkGridView *grid_view, guint position, GtkListScrollFlags flags) {
+//     #if GTK_CHECK_VERSION(4, 12, 0)
+//     gtk_grid_view_scroll_to(grid_view, position, flags, NULL);
+//     #endif
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+// GridViewOption is a function that configures a grid view
+type GridViewOption func(*GridView)
+
+// GridView represents a GTK grid view, which shows the items of a model in
+// a grid, e.g. as thumbnails or icons. Items are created by a factory, as
+// for ListView, and are reflowed into more or fewer columns as the width of
+// the grid view changes. Put it in a ScrolledWindow.
+type GridView struct {
+	BaseWidget
+	selectionModel SelectionModel
+	factory        ListItemFactory
+}
+
+// NewGridView creates a new GTK grid view
+func NewGridView(selectionModel SelectionModel, factory ListItemFactory, options ...GridViewOption) *GridView {
+	var model *C.GtkSelectionModel
+	if selectionModel != nil {
+		model = selectionModel.GetSelectionModel()
+	}
+	var cFactory *C.GtkListItemFactory
+	if factory != nil {
+		cFactory = factory.GetListItemFactory()
+	}
+
+	gridView := &GridView{
+		BaseWidget: BaseWidget{
+			widget: C.createGridView(model, cFactory),
+		},
+		selectionModel: selectionModel,
+		factory:        factory,
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(gridView)
+	}
+
+	SetupFinalization(gridView, gridView.Destroy)
+	return gridView
+}
+
+// WithMaxColumns sets the maximum number of columns
+func WithMaxColumns(n int) GridViewOption {
+	return func(gv *GridView) {
+		gv.SetMaxColumns(n)
+	}
+}
+
+// WithMinColumns sets the minimum number of columns
+func WithMinColumns(n int) GridViewOption {
+	return func(gv *GridView) {
+		gv.SetMinColumns(n)
+	}
+}
+
+// WithGridViewSingleClickActivate sets whether items are activated on single click
+func WithGridViewSingleClickActivate(singleClickActivate bool) GridViewOption {
+	return func(gv *GridView) {
+		gv.SetSingleClickActivate(singleClickActivate)
+	}
+}
+
+// gridView returns the widget as a GtkGridView
+func (gv *GridView) gridView() *C.GtkGridView {
+	return (*C.GtkGridView)(unsafe.Pointer(gv.widget))
+}
+
+// SetModel sets the selection model for the grid view
+func (gv *GridView) SetModel(model SelectionModel) {
+	if model != nil {
+		C.gtk_grid_view_set_model(gv.gridView(), model.GetSelectionModel())
+	} else {
+		C.gtk_grid_view_set_model(gv.gridView(), nil)
+	}
+	gv.selectionModel = model
+}
+
+// GetModel returns the selection model for the grid view
+func (gv *GridView) GetModel() SelectionModel {
+	return gv.selectionModel
+}
+
+// SetFactory sets the list item factory for the grid view
+func (gv *GridView) SetFactory(factory ListItemFactory) {
+	if factory != nil {
+		C.gtk_grid_view_set_factory(gv.gridView(), factory.GetListItemFactory())
+	} else {
+		C.gtk_grid_view_set_factory(gv.gridView(), nil)
+	}
+	gv.factory = factory
+}
+
+// GetFactory returns the list item factory for the grid view
+func (gv *GridView) GetFactory() ListItemFactory {
+	return gv.factory
+}
+
+// SetMaxColumns sets the maximum number of columns
+func (gv *GridView) SetMaxColumns(n int) {
+	C.gtk_grid_view_set_max_columns(gv.gridView(), C.guint(n))
+}
+
+// GetMaxColumns returns the maximum number of columns
+func (gv *GridView) GetMaxColumns() int {
+	return int(C.gtk_grid_view_get_max_columns(gv.gridView()))
+}
+
+// SetMinColumns sets the minimum number of columns
+func (gv *GridView) SetMinColumns(n int) {
+	C.gtk_grid_view_set_min_columns(gv.gridView(), C.guint(n))
+}
+
+// GetMinColumns returns the minimum number of columns
+func (gv *GridView) GetMinColumns() int {
+	return int(C.gtk_grid_view_get_min_columns(gv.gridView()))
+}
+
+// SetEnableRubberband sets whether to enable rubberband selection
+func (gv *GridView) SetEnableRubberband(enableRubberband bool) {
+	var cenableRubberband C.gboolean
+	if enableRubberband {
+		cenableRubberband = C.TRUE
+	} else {
+		cenableRubberband = C.FALSE
+	}
+	C.gtk_grid_view_set_enable_rubberband(gv.gridView(), cenableRubberband)
+}
+
+// GetEnableRubberband returns whether rubberband selection is enabled
+func (gv *GridView) GetEnableRubberband() bool {
+	return C.gtk_grid_view_get_enable_rubberband(gv.gridView()) != 0
+}
+
+// SetSingleClickActivate sets whether items are activated on single click
+func (gv *GridView) SetSingleClickActivate(singleClickActivate bool) {
+	var csingleClickActivate C.gboolean
+	if singleClickActivate {
+		csingleClickActivate = C.TRUE
+	} else {
+		csingleClickActivate = C.FALSE
+	}
+	C.gtk_grid_view_set_single_click_activate(gv.gridView(), csingleClickActivate)
+}
+
+// ScrollTo scrolls to the item at the given position (GTK 4.12+)
+func (gv *GridView) ScrollTo(position int, flags ListScrollFlags) {
+	C.gridViewScrollTo(gv.gridView(), C.guint(position), C.GtkListScrollFlags(flags))
+}
+
+// ConnectActivate connects a callback for item activation. The callback
+// receives the position of the activated item. The returned ID can be used
+// with Disconnect.
+func (gv *GridView) ConnectActivate(callback func(position int)) uint64 {
+	if callback == nil {
+		return 0
+	}
+
+	// Connect using the unified callback system, which delivers the
+	// position as an int for list activation signals
+	return Connect(gv, SignalListActivate, callback)
+}
+
+// DisconnectActivate disconnects all activate signal handlers
+func (gv *GridView) DisconnectActivate() {
+	viewPtr := uintptr(unsafe.Pointer(gv.widget))
+	for _, id := range getCallbackIDsForSignal(viewPtr, SignalListActivate) {
+		Disconnect(id)
+	}
+}
+
+// Destroy destroys the grid view and cleans up resources
+func (gv *GridView) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(gv)
+
+	// Call base destroy method
+	gv.BaseWidget.Destroy()
+}
@@ -0,0 +1,245 @@
+// Package gtk4 provides GSettings functionality for GTK4
+// File: gtk4go/gtk4/gsettings.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// // Returns whether the schema is installed; g_settings_new aborts otherwise
+// static gboolean settingsSchemaExists(const char *schema_id) {
+//     GSettingsSchemaSource *source = g_settings_schema_source_get_default();
+//     if (source == NULL) {
+//         return FALSE;
+//     }
+//     GSettingsSchema *schema = g_settings_schema_source_lookup(source, schema_id, TRUE);
+//     if (schema == NULL) {
+//         return FALSE;
+//     }
+//     g_settings_schema_unref(schema);
+//     return TRUE;
+// }
+//
+// // Returns whether the schema has the key with the given GVariant type, or
+// // any type if type is NULL; the getters and setters abort otherwise
+// static gboolean settingsHasKey(GSettings *settings, const char *key, const char *type) {
+//     GSettingsSchema *schema = NULL;
+//     g_object_get(settings, "settings-schema", &schema, NULL);
+//     if (schema == NULL) {
+//         return FALSE;
+//     }
+//     gboolean ok = g_settings_schema_has_key(schema, key);
+//     if (ok && type != NULL) {
+//         GSettingsSchemaKey *schema_key = g_settings_schema_get_key(schema, key);
+//         ok = g_variant_type_equal(g_settings_schema_key_get_value_type(schema_key), G_VARIANT_TYPE(type));
+//         g_settings_schema_key_unref(schema_key);
+//     }
+//     g_settings_schema_unref(schema);
+//     return ok;
+// }
+//
+// static gboolean objectHasProperty(GObject *object, const char *property) {
+//     return g_object_class_find_property(G_OBJECT_GET_CLASS(object), property) != NULL;
+// }
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// SignalSettingsChanged is emitted when a key of a GSettings object changes
+const SignalSettingsChanged SignalType = "changed"
+
+// GSettings provides access to the application preferences stored with
+// GSettings, e.g. in dconf on Linux. Keys are defined by a schema, which must
+// be compiled and installed (or found through GSETTINGS_SCHEMA_DIR) before
+// the settings can be opened. Not to be confused with Settings, which holds
+// the GTK settings of a display.
+type GSettings struct {
+	settings *C.GSettings
+}
+
+// NewGSettings opens the settings of the given schema, e.g.
+// "com.example.App". An error is returned if the schema isn't installed.
+func NewGSettings(schemaID string) (*GSettings, error) {
+	cSchemaID := C.CString(schemaID)
+	defer C.free(unsafe.Pointer(cSchemaID))
+
+	if C.settingsSchemaExists(cSchemaID) == C.FALSE {
+		return nil, &GTKError{Op: "NewGSettings", Err: fmt.Errorf("schema %q is not installed", schemaID)}
+	}
+
+	settings := &GSettings{settings: C.g_settings_new(cSchemaID)}
+	runtime.SetFinalizer(settings, (*GSettings).Destroy)
+	return settings, nil
+}
+
+// Native returns the underlying GSettings pointer as uintptr
+func (s *GSettings) Native() uintptr {
+	return uintptr(unsafe.Pointer(s.settings))
+}
+
+// checkKey returns an error if the schema has no key with the given name and
+// GVariant type (e.g. "b" for booleans), or any type if valueType is empty
+func (s *GSettings) checkKey(op, key, valueType string) error {
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+
+	var cType *C.char
+	if valueType != "" {
+		cType = C.CString(valueType)
+		defer C.free(unsafe.Pointer(cType))
+	}
+
+	if C.settingsHasKey(s.settings, cKey, cType) == C.FALSE {
+		if valueType == "" {
+			return &GTKError{Op: op, Err: fmt.Errorf("no key %q in schema", key)}
+		}
+		return &GTKError{Op: op, Err: fmt.Errorf("no key %q of type %q in schema", key, valueType)}
+	}
+	return nil
+}
+
+// GetBool returns the value of a boolean key
+func (s *GSettings) GetBool(key string) (bool, error) {
+	if err := s.checkKey("GSettings.GetBool", key, "b"); err != nil {
+		return false, err
+	}
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+	return C.g_settings_get_boolean(s.settings, cKey) == C.TRUE, nil
+}
+
+// SetBool sets the value of a boolean key
+func (s *GSettings) SetBool(key string, value bool) error {
+	if err := s.checkKey("GSettings.SetBool", key, "b"); err != nil {
+		return err
+	}
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+
+	var cvalue C.gboolean
+	if value {
+		cvalue = C.TRUE
+	} else {
+		cvalue = C.FALSE
+	}
+	if C.g_settings_set_boolean(s.settings, cKey, cvalue) == C.FALSE {
+		return &GTKError{Op: "GSettings.SetBool", Err: fmt.Errorf("key %q is not writable", key)}
+	}
+	return nil
+}
+
+// GetInt returns the value of an integer key
+func (s *GSettings) GetInt(key string) (int, error) {
+	if err := s.checkKey("GSettings.GetInt", key, "i"); err != nil {
+		return 0, err
+	}
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+	return int(C.g_settings_get_int(s.settings, cKey)), nil
+}
+
+// SetInt sets the value of an integer key. Values outside the range
+// defined in the schema are rejected.
+func (s *GSettings) SetInt(key string, value int) error {
+	if err := s.checkKey("GSettings.SetInt", key, "i"); err != nil {
+		return err
+	}
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+
+	if C.g_settings_set_int(s.settings, cKey, C.gint(value)) == C.FALSE {
+		return &GTKError{Op: "GSettings.SetInt", Err: fmt.Errorf("can't set key %q to %d", key, value)}
+	}
+	return nil
+}
+
+// GetString returns the value of a string key
+func (s *GSettings) GetString(key string) (string, error) {
+	if err := s.checkKey("GSettings.GetString", key, "s"); err != nil {
+		return "", err
+	}
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+
+	cValue := C.g_settings_get_string(s.settings, cKey)
+	defer C.g_free(C.gpointer(unsafe.Pointer(cValue)))
+	return C.GoString(cValue), nil
+}
+
+// SetString sets the value of a string key
+func (s *GSettings) SetString(key string, value string) error {
+	if err := s.checkKey("GSettings.SetString", key, "s"); err != nil {
+		return err
+	}
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+	cValue := C.CString(value)
+	defer C.free(unsafe.Pointer(cValue))
+
+	if C.g_settings_set_string(s.settings, cKey, cValue) == C.FALSE {
+		return &GTKError{Op: "GSettings.SetString", Err: fmt.Errorf("key %q is not writable", key)}
+	}
+	return nil
+}
+
+// Bind binds a key to a property of a widget or object in both directions,
+// e.g. a setting to the "active" property of a switch: the property is set
+// from the key right away and whenever the key changes, and the key is set
+// whenever the property changes. The binding is removed when the object is
+// destroyed, or with Unbind.
+func (s *GSettings) Bind(key string, object interface{}, property string) error {
+	if err := s.checkKey("GSettings.Bind", key, ""); err != nil {
+		return err
+	}
+
+	objectPtr := getObjectPointer(object)
+	if objectPtr == 0 {
+		return &GTKError{Op: "GSettings.Bind", Err: fmt.Errorf("can't bind %T", object)}
+	}
+	cObject := (*C.GObject)(unsafe.Pointer(objectPtr))
+
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+	cProperty := C.CString(property)
+	defer C.free(unsafe.Pointer(cProperty))
+
+	if C.objectHasProperty(cObject, cProperty) == C.FALSE {
+		return &GTKError{Op: "GSettings.Bind", Err: fmt.Errorf("%T has no property %q", object, property)}
+	}
+
+	C.g_settings_bind(s.settings, cKey, C.gpointer(unsafe.Pointer(cObject)), cProperty, C.G_SETTINGS_BIND_DEFAULT)
+	return nil
+}
+
+// Unbind removes the binding of a property set up with Bind
+func (s *GSettings) Unbind(object interface{}, property string) {
+	objectPtr := getObjectPointer(object)
+	if objectPtr == 0 {
+		return
+	}
+
+	cProperty := C.CString(property)
+	defer C.free(unsafe.Pointer(cProperty))
+	C.g_settings_unbind(C.gpointer(unsafe.Pointer(objectPtr)), cProperty)
+}
+
+// ConnectChanged connects a callback for changes of any key, whether made
+// by this application or another program. The callback receives the name of
+// the changed key.
+func (s *GSettings) ConnectChanged(callback func(key string)) uint64 {
+	return Connect(s, SignalSettingsChanged, callback)
+}
+
+// Destroy releases the settings. Bindings keep working until their objects
+// are destroyed.
+func (s *GSettings) Destroy() {
+	if s.settings != nil {
+		DisconnectAll(s)
+		C.g_object_unref(C.gpointer(unsafe.Pointer(s.settings)))
+		s.settings = nil
+	}
+}
@@ -0,0 +1,203 @@
+// Package gtk4test provides helpers for testing code built on the gtk4
+// package, such as emitting signals and recording callback calls
+// File: gtk4go/gtk4/gtk4test/gtk4test.go
+package gtk4test
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// // Emit a signal that takes no arguments and returns nothing
+// static void emitSignal(guintptr object, const char *signal) {
+//     g_signal_emit_by_name((gpointer)object, signal);
+// }
+import "C"
+
+import (
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/justyntemme/gtk4go/core/uithread"
+	"github.com/justyntemme/gtk4go/gtk4"
+)
+
+// Emit emits a signal that takes no arguments, such as SignalClicked or
+// SignalActivate, on a widget or object as if it had been triggered by the
+// user. Handlers that dispatch their callback through the UI thread queue
+// may not have run when Emit returns; use TriggerSignal or Flush to wait for
+// them. Must be called on the UI thread.
+func Emit(object gtk4.Object, signal gtk4.SignalType) {
+	objectPtr := object.Native()
+	if objectPtr == 0 {
+		gtk4.DebugLog(gtk4.DebugLevelError, gtk4.DebugComponentCallback, "Emit failed: %T has no native object", object)
+		return
+	}
+
+	cSignal := C.CString(string(signal))
+	defer C.free(unsafe.Pointer(cSignal))
+	C.emitSignal(C.guintptr(objectPtr), cSignal)
+}
+
+// IterateMainLoop processes all pending events and idle callbacks of the
+// default main context without blocking. Returns the number of iterations run.
+// Must be called on the UI thread.
+func IterateMainLoop() int {
+	iterations := 0
+	for C.g_main_context_pending(nil) == C.TRUE {
+		C.g_main_context_iteration(nil, C.FALSE)
+		iterations++
+	}
+	return iterations
+}
+
+// Flush runs the main loop until all functions queued for the UI thread,
+// including callbacks queued from other goroutines, have run (see
+// uithread.QueueDepth). Must be called on the UI thread.
+func Flush() {
+	for {
+		IterateMainLoop()
+		if uithread.QueueDepth() == 0 {
+			return
+		}
+
+		// Work is queued but not yet attached to the main loop
+		if uithread.RegisterIdleHandler == nil {
+			// The dispatcher runs it on its own goroutine
+			time.Sleep(time.Millisecond)
+		} else {
+			// Attaching the idle source wakes up the main context
+			C.g_main_context_iteration(nil, C.TRUE)
+		}
+	}
+}
+
+// TriggerSignal emits a signal that takes no arguments and then runs the
+// main loop until the connected callbacks, and any work they queued for the
+// UI thread, have run. Must be called on the UI thread.
+func TriggerSignal(object gtk4.Object, signal gtk4.SignalType) {
+	Emit(object, signal)
+	Flush()
+}
+
+// CallbackRecorder records calls to callbacks so that tests can check that a
+// handler ran and with which arguments. Use one of the typed methods, such as
+// Func or FuncInt, to get a callback to connect to a signal.
+type CallbackRecorder struct {
+	mu    sync.Mutex
+	calls [][]interface{}
+}
+
+// NewCallbackRecorder creates a new callback recorder
+func NewCallbackRecorder() *CallbackRecorder {
+	return &CallbackRecorder{}
+}
+
+// record stores the arguments of a call
+func (r *CallbackRecorder) record(args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, args)
+}
+
+// Func returns a recording callback for signals without arguments
+func (r *CallbackRecorder) Func() func() {
+	return func() {
+		r.record()
+	}
+}
+
+// FuncInt returns a recording callback for signals with an int argument
+func (r *CallbackRecorder) FuncInt() func(int) {
+	return func(i int) {
+		r.record(i)
+	}
+}
+
+// FuncIntInt returns a recording callback for signals with two int arguments
+func (r *CallbackRecorder) FuncIntInt() func(int, int) {
+	return func(i1, i2 int) {
+		r.record(i1, i2)
+	}
+}
+
+// FuncResponse returns a recording callback for dialog responses
+func (r *CallbackRecorder) FuncResponse() func(gtk4.ResponseType) {
+	return func(response gtk4.ResponseType) {
+		r.record(response)
+	}
+}
+
+// FuncBool returns a recording callback for signals that return a value.
+// The callback records the call and returns result.
+func (r *CallbackRecorder) FuncBool(result bool) func() bool {
+	return func() bool {
+		r.record()
+		return result
+	}
+}
+
+// FuncClick returns a recording callback for gesture press/release signals
+func (r *CallbackRecorder) FuncClick() func(int, float64, float64) {
+	return func(nPress int, x, y float64) {
+		r.record(nPress, x, y)
+	}
+}
+
+// Called returns whether the callback has been called at least once
+func (r *CallbackRecorder) Called() bool {
+	return r.CallCount() > 0
+}
+
+// CallCount returns the number of times the callback has been called
+func (r *CallbackRecorder) CallCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.calls)
+}
+
+// Args returns the arguments of the nth call (starting at 0),
+// or nil if there was no such call
+func (r *CallbackRecorder) Args(n int) []interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if n < 0 || n >= len(r.calls) {
+		return nil
+	}
+	return r.calls[n]
+}
+
+// LastArgs returns the arguments of the most recent call, or nil if the
+// callback has not been called
+func (r *CallbackRecorder) LastArgs() []interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.calls) == 0 {
+		return nil
+	}
+	return r.calls[len(r.calls)-1]
+}
+
+// Reset forgets all recorded calls
+func (r *CallbackRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+// WaitForCalls iterates the main loop until the callback has been called at
+// least count times or the timeout expires. Returns whether the count was
+// reached. Must be called on the UI thread.
+func (r *CallbackRecorder) WaitForCalls(count int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for r.CallCount() < count {
+		if time.Now().After(deadline) {
+			return false
+		}
+		if IterateMainLoop() == 0 {
+			// Nothing pending yet, give background work a moment
+			time.Sleep(time.Millisecond)
+		}
+	}
+	return true
+}
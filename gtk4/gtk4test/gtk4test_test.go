@@ -0,0 +1,103 @@
+package gtk4test_test
+
+import (
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/justyntemme/gtk4go"
+	"github.com/justyntemme/gtk4go/core/uithread"
+	"github.com/justyntemme/gtk4go/gtk4"
+	"github.com/justyntemme/gtk4go/gtk4/gtk4test"
+)
+
+// TestMain runs the main loop on the main thread, where GTK was
+// initialized, while the tests run on other goroutines
+func TestMain(m *testing.M) {
+	var done atomic.Bool
+	code := 0
+	go func() {
+		code = m.Run()
+		done.Store(true)
+	}()
+
+	for !done.Load() {
+		if gtk4test.IterateMainLoop() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	os.Exit(code)
+}
+
+// onUIThread runs fn on the UI thread, failing the test if the main loop
+// doesn't run it in time
+func onUIThread(t *testing.T, fn func()) {
+	t.Helper()
+	if err := gtk4go.RunOnUIThreadTimeout(fn, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTriggerSignalRunsHandler(t *testing.T) {
+	recorder := gtk4test.NewCallbackRecorder()
+	onUIThread(t, func() {
+		button := gtk4.NewButton("Click")
+		button.ConnectClicked(recorder.Func())
+
+		gtk4test.TriggerSignal(button, gtk4.SignalClicked)
+		gtk4test.TriggerSignal(button, gtk4.SignalClicked)
+	})
+
+	if got := recorder.CallCount(); got != 2 {
+		t.Errorf("clicked handler called %d times, want 2", got)
+	}
+}
+
+func TestFlushRunsWorkQueuedFromOtherGoroutines(t *testing.T) {
+	recorder := gtk4test.NewCallbackRecorder()
+	onUIThread(t, func() {
+		// RunOnUIThread returns once the function is queued; the dispatcher
+		// may not have attached it to the main loop yet
+		queued := make(chan struct{})
+		go func() {
+			uithread.RunOnUIThread(recorder.Func())
+			close(queued)
+		}()
+		<-queued
+
+		gtk4test.Flush()
+		if !recorder.Called() {
+			t.Error("function queued for the UI thread didn't run during Flush")
+		}
+	})
+}
+
+func TestCallbackRecorderArgs(t *testing.T) {
+	recorder := gtk4test.NewCallbackRecorder()
+	if recorder.Called() || recorder.LastArgs() != nil {
+		t.Fatal("new recorder has calls")
+	}
+
+	callback := recorder.FuncIntInt()
+	callback(1, 2)
+	callback(3, 4)
+
+	if got := recorder.CallCount(); got != 2 {
+		t.Fatalf("CallCount = %d, want 2", got)
+	}
+	if args := recorder.Args(0); len(args) != 2 || args[0] != 1 || args[1] != 2 {
+		t.Errorf("Args(0) = %v, want [1 2]", args)
+	}
+	if args := recorder.LastArgs(); len(args) != 2 || args[0] != 3 || args[1] != 4 {
+		t.Errorf("LastArgs() = %v, want [3 4]", args)
+	}
+	if args := recorder.Args(2); args != nil {
+		t.Errorf("Args(2) = %v, want nil", args)
+	}
+
+	recorder.Reset()
+	if recorder.Called() {
+		t.Error("recorder has calls after Reset")
+	}
+}
@@ -0,0 +1,328 @@
+// Package gtk4 provides GValue conversion and generic property access for GTK4
+// File: gtk4go/gtk4/gvalue.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// // Look up a property on an object's class, returns NULL if it doesn't exist
+// static GParamSpec* findObjectProperty(GObject *object, const char *name) {
+//     return g_object_class_find_property(G_OBJECT_GET_CLASS(object), name);
+// }
+//
+// static GType paramSpecValueType(GParamSpec *pspec) {
+//     return G_PARAM_SPEC_VALUE_TYPE(pspec);
+// }
+//
+// static gboolean paramSpecIsReadable(GParamSpec *pspec) {
+//     return (pspec->flags & G_PARAM_READABLE) != 0;
+// }
+//
+// static gboolean paramSpecIsWritable(GParamSpec *pspec) {
+//     return (pspec->flags & G_PARAM_WRITABLE) != 0;
+// }
+//
+// static GType gvalueFundamentalType(const GValue *value) {
+//     return G_TYPE_FUNDAMENTAL(G_VALUE_TYPE(value));
+// }
+//
+// static GValue* newGValue(GType type) {
+//     GValue *value = g_new0(GValue, 1);
+//     g_value_init(value, type);
+//     return value;
+// }
+//
+// static void freeGValue(GValue *value) {
+//     g_value_unset(value);
+//     g_free(value);
+// }
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// ValueFromGValue converts a GValue to the corresponding Go value.
+// Integers are returned as int, int64, uint or uint64 depending on their size,
+// floating point values as float64, enums as int, flags as uint and
+// objects as their uintptr pointer. Unsupported types return nil.
+func ValueFromGValue(value *C.GValue) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	switch C.gvalueFundamentalType(value) {
+	case C.G_TYPE_BOOLEAN:
+		return C.g_value_get_boolean(value) == C.TRUE
+	case C.G_TYPE_CHAR:
+		return int(C.g_value_get_schar(value))
+	case C.G_TYPE_UCHAR:
+		return uint(C.g_value_get_uchar(value))
+	case C.G_TYPE_INT:
+		return int(C.g_value_get_int(value))
+	case C.G_TYPE_UINT:
+		return uint(C.g_value_get_uint(value))
+	case C.G_TYPE_LONG:
+		return int64(C.g_value_get_long(value))
+	case C.G_TYPE_ULONG:
+		return uint64(C.g_value_get_ulong(value))
+	case C.G_TYPE_INT64:
+		return int64(C.g_value_get_int64(value))
+	case C.G_TYPE_UINT64:
+		return uint64(C.g_value_get_uint64(value))
+	case C.G_TYPE_FLOAT:
+		return float64(C.g_value_get_float(value))
+	case C.G_TYPE_DOUBLE:
+		return float64(C.g_value_get_double(value))
+	case C.G_TYPE_STRING:
+		cStr := C.g_value_get_string(value)
+		if cStr == nil {
+			return ""
+		}
+		return C.GoString(cStr)
+	case C.G_TYPE_ENUM:
+		return int(C.g_value_get_enum(value))
+	case C.G_TYPE_FLAGS:
+		return uint(C.g_value_get_flags(value))
+	case C.G_TYPE_OBJECT:
+		return uintptr(unsafe.Pointer(C.g_value_get_object(value)))
+	}
+
+	return nil
+}
+
+// SetGValueFromValue stores a Go value in an initialized GValue, converting it
+// to the GValue's type. Numeric values may be given as any Go integer or float type.
+func SetGValueFromValue(value *C.GValue, goValue interface{}) error {
+	if value == nil {
+		return &GTKError{Op: "SetGValueFromValue", Err: fmt.Errorf("nil GValue")}
+	}
+
+	switch C.gvalueFundamentalType(value) {
+	case C.G_TYPE_BOOLEAN:
+		b, ok := goValue.(bool)
+		if !ok {
+			return gvalueTypeError(goValue, "bool")
+		}
+		C.g_value_set_boolean(value, boolToGBoolean(b))
+	case C.G_TYPE_CHAR:
+		i, ok := toInt64(goValue)
+		if !ok {
+			return gvalueTypeError(goValue, "integer")
+		}
+		C.g_value_set_schar(value, C.gint8(i))
+	case C.G_TYPE_UCHAR:
+		i, ok := toInt64(goValue)
+		if !ok {
+			return gvalueTypeError(goValue, "integer")
+		}
+		C.g_value_set_uchar(value, C.guchar(i))
+	case C.G_TYPE_INT:
+		i, ok := toInt64(goValue)
+		if !ok {
+			return gvalueTypeError(goValue, "integer")
+		}
+		C.g_value_set_int(value, C.gint(i))
+	case C.G_TYPE_UINT:
+		i, ok := toInt64(goValue)
+		if !ok {
+			return gvalueTypeError(goValue, "integer")
+		}
+		C.g_value_set_uint(value, C.guint(i))
+	case C.G_TYPE_LONG:
+		i, ok := toInt64(goValue)
+		if !ok {
+			return gvalueTypeError(goValue, "integer")
+		}
+		C.g_value_set_long(value, C.glong(i))
+	case C.G_TYPE_ULONG:
+		i, ok := toInt64(goValue)
+		if !ok {
+			return gvalueTypeError(goValue, "integer")
+		}
+		C.g_value_set_ulong(value, C.gulong(i))
+	case C.G_TYPE_INT64:
+		i, ok := toInt64(goValue)
+		if !ok {
+			return gvalueTypeError(goValue, "integer")
+		}
+		C.g_value_set_int64(value, C.gint64(i))
+	case C.G_TYPE_UINT64:
+		i, ok := toInt64(goValue)
+		if !ok {
+			return gvalueTypeError(goValue, "integer")
+		}
+		C.g_value_set_uint64(value, C.guint64(i))
+	case C.G_TYPE_FLOAT:
+		f, ok := toFloat64(goValue)
+		if !ok {
+			return gvalueTypeError(goValue, "number")
+		}
+		C.g_value_set_float(value, C.gfloat(f))
+	case C.G_TYPE_DOUBLE:
+		f, ok := toFloat64(goValue)
+		if !ok {
+			return gvalueTypeError(goValue, "number")
+		}
+		C.g_value_set_double(value, C.gdouble(f))
+	case C.G_TYPE_STRING:
+		s, ok := goValue.(string)
+		if !ok {
+			return gvalueTypeError(goValue, "string")
+		}
+		WithCString(s, func(cStr *C.char) {
+			// g_value_set_string copies the string
+			C.g_value_set_string(value, cStr)
+		})
+	case C.G_TYPE_ENUM:
+		i, ok := toInt64(goValue)
+		if !ok {
+			return gvalueTypeError(goValue, "integer")
+		}
+		C.g_value_set_enum(value, C.gint(i))
+	case C.G_TYPE_FLAGS:
+		i, ok := toInt64(goValue)
+		if !ok {
+			return gvalueTypeError(goValue, "integer")
+		}
+		C.g_value_set_flags(value, C.guint(i))
+	case C.G_TYPE_OBJECT:
+		var ptr uintptr
+		switch v := goValue.(type) {
+		case nil:
+			ptr = 0
+		case uintptr:
+			ptr = v
+		case Object:
+			ptr = v.Native()
+		default:
+			return gvalueTypeError(goValue, "Object or uintptr")
+		}
+		C.g_value_set_object(value, C.gpointer(unsafe.Pointer(ptr)))
+	default:
+		return &GTKError{
+			Op:  "SetGValueFromValue",
+			Err: fmt.Errorf("unsupported GValue type %s", C.GoString(C.g_type_name(value.g_type))),
+		}
+	}
+
+	return nil
+}
+
+// gvalueTypeError builds the error returned when a Go value can't be stored in a GValue
+func gvalueTypeError(goValue interface{}, expected string) error {
+	return &GTKError{
+		Op:  "SetGValueFromValue",
+		Err: fmt.Errorf("cannot convert %T to %s", goValue, expected),
+	}
+}
+
+// toInt64 converts any Go integer type to int64
+func toInt64(goValue interface{}) (int64, bool) {
+	switch v := goValue.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint:
+		return int64(v), true
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case uint64:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// toFloat64 converts any Go numeric type to float64
+func toFloat64(goValue interface{}) (float64, bool) {
+	switch v := goValue.(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	if i, ok := toInt64(goValue); ok {
+		return float64(i), true
+	}
+	return 0, false
+}
+
+// getObjectProperty reads a GObject property into a Go value
+func getObjectProperty(object *C.GObject, name string) (interface{}, error) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	pspec := C.findObjectProperty(object, cName)
+	if pspec == nil {
+		return nil, &GTKError{Op: "GetProperty", Err: fmt.Errorf("unknown property %q", name)}
+	}
+	if C.paramSpecIsReadable(pspec) == C.FALSE {
+		return nil, &GTKError{Op: "GetProperty", Err: fmt.Errorf("property %q is not readable", name)}
+	}
+
+	value := C.newGValue(C.paramSpecValueType(pspec))
+	defer C.freeGValue(value)
+
+	C.g_object_get_property(object, cName, value)
+	return ValueFromGValue(value), nil
+}
+
+// setObjectProperty writes a Go value to a GObject property
+func setObjectProperty(object *C.GObject, name string, goValue interface{}) error {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	pspec := C.findObjectProperty(object, cName)
+	if pspec == nil {
+		return &GTKError{Op: "SetProperty", Err: fmt.Errorf("unknown property %q", name)}
+	}
+	if C.paramSpecIsWritable(pspec) == C.FALSE {
+		return &GTKError{Op: "SetProperty", Err: fmt.Errorf("property %q is not writable", name)}
+	}
+
+	value := C.newGValue(C.paramSpecValueType(pspec))
+	defer C.freeGValue(value)
+
+	if err := SetGValueFromValue(value, goValue); err != nil {
+		return err
+	}
+
+	C.g_object_set_property(object, cName, value)
+	return nil
+}
+
+// GetProperty gets the value of any GObject property of the widget by name.
+// This gives access to properties that have no dedicated wrapper method.
+// Unknown or unreadable properties are logged and return nil.
+func (w *BaseWidget) GetProperty(name string) interface{} {
+	value, err := getObjectProperty(CastToGObject(w.widget), name)
+	if err != nil {
+		DebugLog(DebugLevelError, DebugComponentGeneral, "%v", err)
+		return nil
+	}
+	return value
+}
+
+// SetProperty sets the value of any GObject property of the widget by name.
+// Returns an error if the property doesn't exist, isn't writable, or the
+// value can't be converted to the property's type.
+func (w *BaseWidget) SetProperty(name string, value interface{}) error {
+	err := setObjectProperty(CastToGObject(w.widget), name, value)
+	if err != nil {
+		DebugLog(DebugLevelError, DebugComponentGeneral, "%v", err)
+	}
+	return err
+}
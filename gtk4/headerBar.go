@@ -107,7 +107,7 @@ func WithTitle(title string) HeaderBarOption {
 // WithTitleWidget sets a custom widget as the header bar title
 func WithTitleWidget(titleWidget Widget) HeaderBarOption {
     return func(hb *HeaderBar) {
-        C.setHeaderBarTitleWidget((*C.GtkHeaderBar)(unsafe.Pointer(hb.widget)), titleWidget.GetWidget())
+        hb.SetTitleWidget(titleWidget)
     }
 }
 
@@ -138,8 +138,14 @@ func (hb *HeaderBar) SetTitle(title string) {
     C.setHeaderBarTitle((*C.GtkHeaderBar)(unsafe.Pointer(hb.widget)), cTitle)
 }
 
-// SetTitleWidget sets a custom widget as the header bar title
+// SetTitleWidget sets a custom widget as the header bar title, such as a
+// WindowTitle or a StackSwitcher, replacing the title set with SetTitle.
+// nil restores the default title, which shows the window title.
 func (hb *HeaderBar) SetTitleWidget(titleWidget Widget) {
+    if titleWidget == nil {
+        C.setHeaderBarTitleWidget((*C.GtkHeaderBar)(unsafe.Pointer(hb.widget)), nil)
+        return
+    }
     C.setHeaderBarTitleWidget((*C.GtkHeaderBar)(unsafe.Pointer(hb.widget)), titleWidget.GetWidget())
 }
 
@@ -150,12 +156,42 @@ func (hb *HeaderBar) SetDecorationLayout(layout string) {
     C.setHeaderBarDecorationLayout((*C.GtkHeaderBar)(unsafe.Pointer(hb.widget)), cLayout)
 }
 
-// PackStart adds a widget to the start of the header bar
+// PackStart adds a widget to the start of the header bar. Widgets that
+// already have a parent, including ones packed before, are rejected.
 func (hb *HeaderBar) PackStart(child Widget) {
+    if !hb.canPack(child, "PackStart") {
+        return
+    }
     C.packStart((*C.GtkHeaderBar)(unsafe.Pointer(hb.widget)), child.GetWidget())
 }
 
-// PackEnd adds a widget to the end of the header bar
+// PackEnd adds a widget to the end of the header bar. Widgets that
+// already have a parent, including ones packed before, are rejected.
 func (hb *HeaderBar) PackEnd(child Widget) {
+    if !hb.canPack(child, "PackEnd") {
+        return
+    }
     C.packEnd((*C.GtkHeaderBar)(unsafe.Pointer(hb.widget)), child.GetWidget())
+}
+
+// Remove removes a widget packed with PackStart or PackEnd, or the title widget
+func (hb *HeaderBar) Remove(child Widget) {
+    if child == nil {
+        return
+    }
+    C.gtk_header_bar_remove((*C.GtkHeaderBar)(unsafe.Pointer(hb.widget)), child.GetWidget())
+}
+
+// canPack checks that a widget can be packed, logging why it can't
+func (hb *HeaderBar) canPack(child Widget, op string) bool {
+    if child == nil || child.GetWidget() == nil {
+        DebugLog(DebugLevelWarning, DebugComponentGeneral, "HeaderBar.%s: nil widget", op)
+        return false
+    }
+    if C.gtk_widget_get_parent(child.GetWidget()) != nil {
+        DebugLog(DebugLevelWarning, DebugComponentGeneral,
+            "HeaderBar.%s: widget %p already has a parent", op, child.GetWidget())
+        return false
+    }
+    return true
 }
\ No newline at end of file
@@ -0,0 +1,84 @@
+// Package gtk4 provides image functionality for GTK4
+// File: gtk4go/gtk4/image.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// ImageOption is a function that configures an image
+type ImageOption func(*Image)
+
+// Image represents a GTK image widget
+type Image struct {
+	BaseWidget
+}
+
+// NewImageFromIconName creates a new image showing a named icon from the icon theme
+func NewImageFromIconName(iconName string, options ...ImageOption) *Image {
+	var widget *C.GtkWidget
+	WithCString(iconName, func(cIconName *C.char) {
+		widget = C.gtk_image_new_from_icon_name(cIconName)
+	})
+
+	image := &Image{
+		BaseWidget: BaseWidget{
+			widget: widget,
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(image)
+	}
+
+	SetupFinalization(image, image.Destroy)
+	return image
+}
+
+// WithPixelSize sets the size of the icon in pixels
+func WithPixelSize(size int) ImageOption {
+	return func(i *Image) {
+		i.SetPixelSize(size)
+	}
+}
+
+// SetFromIconName changes the image to show a named icon
+func (i *Image) SetFromIconName(iconName string) {
+	WithCString(iconName, func(cIconName *C.char) {
+		C.gtk_image_set_from_icon_name((*C.GtkImage)(unsafe.Pointer(i.widget)), cIconName)
+	})
+}
+
+// GetIconName gets the name of the icon shown, or an empty string
+func (i *Image) GetIconName() string {
+	cIconName := C.gtk_image_get_icon_name((*C.GtkImage)(unsafe.Pointer(i.widget)))
+	if cIconName == nil {
+		return ""
+	}
+	return C.GoString(cIconName)
+}
+
+// SetPixelSize sets the size of the icon in pixels
+func (i *Image) SetPixelSize(size int) {
+	C.gtk_image_set_pixel_size((*C.GtkImage)(unsafe.Pointer(i.widget)), C.int(size))
+}
+
+// GetPixelSize gets the size of the icon in pixels
+func (i *Image) GetPixelSize() int {
+	return int(C.gtk_image_get_pixel_size((*C.GtkImage)(unsafe.Pointer(i.widget))))
+}
+
+// Destroy destroys the image and cleans up resources
+func (i *Image) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(i)
+
+	// Call base destroy method
+	i.BaseWidget.Destroy()
+}
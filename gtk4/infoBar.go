@@ -0,0 +1,215 @@
+// Package gtk4 provides info bar functionality for GTK4
+// File: gtk4go/gtk4/infoBar.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// // GtkInfoBar is deprecated since GTK 4.10 in favor of custom banners
+// G_GNUC_BEGIN_IGNORE_DEPRECATIONS
+//
+// extern void infoBarResponseCallback(GtkInfoBar *info_bar, int response_id, gpointer user_data);
+//
+// static gulong connectInfoBarResponse(GtkInfoBar *info_bar, guint callbackId) {
+//     return g_signal_connect(info_bar, "response", G_CALLBACK(infoBarResponseCallback), GUINT_TO_POINTER(callbackId));
+// }
+//
+// static GtkWidget* infoBarNew() {
+//     return gtk_info_bar_new();
+// }
+//
+// static void infoBarSetMessageType(GtkInfoBar *info_bar, GtkMessageType type) {
+//     gtk_info_bar_set_message_type(info_bar, type);
+// }
+//
+// static GtkMessageType infoBarGetMessageType(GtkInfoBar *info_bar) {
+//     return gtk_info_bar_get_message_type(info_bar);
+// }
+//
+// static GtkWidget* infoBarAddButton(GtkInfoBar *info_bar, const char *text, int response_id) {
+//     return gtk_info_bar_add_button(info_bar, text, response_id);
+// }
+//
+// static void infoBarAddChild(GtkInfoBar *info_bar, GtkWidget *child) {
+//     gtk_info_bar_add_child(info_bar, child);
+// }
+//
+// static void infoBarRemoveChild(GtkInfoBar *info_bar, GtkWidget *child) {
+//     gtk_info_bar_remove_child(info_bar, child);
+// }
+//
+// static void infoBarSetRevealed(GtkInfoBar *info_bar, gboolean revealed) {
+//     gtk_info_bar_set_revealed(info_bar, revealed);
+// }
+//
+// static gboolean infoBarGetRevealed(GtkInfoBar *info_bar) {
+//     return gtk_info_bar_get_revealed(info_bar);
+// }
+//
+// static void infoBarSetShowCloseButton(GtkInfoBar *info_bar, gboolean setting) {
+//     gtk_info_bar_set_show_close_button(info_bar, setting);
+// }
+//
+// static void infoBarSetDefaultResponse(GtkInfoBar *info_bar, int response_id) {
+//     gtk_info_bar_set_default_response(info_bar, response_id);
+// }
+//
+// G_GNUC_END_IGNORE_DEPRECATIONS
+import "C"
+
+import (
+	"unsafe"
+)
+
+// SignalInfoBarResponse is emitted when a button of an info bar is clicked
+const SignalInfoBarResponse SignalType = "response"
+
+// InfoBarOption is a function that configures an info bar
+type InfoBarOption func(*InfoBar)
+
+// InfoBar represents a GTK info bar, which shows a message inline, e.g.
+// above the content of a window, instead of in a dialog. It slides in and
+// out when revealed or hidden with SetRevealed.
+type InfoBar struct {
+	BaseWidget
+}
+
+// NewInfoBar creates a new GTK info bar
+func NewInfoBar(options ...InfoBarOption) *InfoBar {
+	infoBar := &InfoBar{
+		BaseWidget: BaseWidget{
+			widget: C.infoBarNew(),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(infoBar)
+	}
+
+	SetupFinalization(infoBar, infoBar.Destroy)
+	return infoBar
+}
+
+// WithMessageType sets the type of message shown by the info bar
+func WithMessageType(messageType MessageType) InfoBarOption {
+	return func(ib *InfoBar) {
+		ib.SetMessageType(messageType)
+	}
+}
+
+// WithShowCloseButton sets whether the info bar has a close button
+func WithShowCloseButton(show bool) InfoBarOption {
+	return func(ib *InfoBar) {
+		ib.SetShowCloseButton(show)
+	}
+}
+
+// WithRevealed sets whether the info bar is initially revealed
+func WithRevealed(revealed bool) InfoBarOption {
+	return func(ib *InfoBar) {
+		ib.SetRevealed(revealed)
+	}
+}
+
+// infoBar returns the widget as a GtkInfoBar
+func (ib *InfoBar) infoBar() *C.GtkInfoBar {
+	return (*C.GtkInfoBar)(unsafe.Pointer(ib.widget))
+}
+
+// SetMessageType sets the type of message, which determines the info bar's
+// color
+func (ib *InfoBar) SetMessageType(messageType MessageType) {
+	C.infoBarSetMessageType(ib.infoBar(), C.GtkMessageType(messageType))
+}
+
+// GetMessageType returns the type of message
+func (ib *InfoBar) GetMessageType() MessageType {
+	return MessageType(C.infoBarGetMessageType(ib.infoBar()))
+}
+
+// AddButton adds a button that emits the given response when clicked
+func (ib *InfoBar) AddButton(text string, responseId ResponseType) *Button {
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+
+	widget := C.infoBarAddButton(ib.infoBar(), cText, C.int(responseId))
+	return &Button{BaseWidget: BaseWidget{widget: widget}}
+}
+
+// AddChild adds a widget, such as a label with the message, to the content
+// area of the info bar
+func (ib *InfoBar) AddChild(child Widget) {
+	C.infoBarAddChild(ib.infoBar(), child.GetWidget())
+}
+
+// RemoveChild removes a widget from the content area of the info bar
+func (ib *InfoBar) RemoveChild(child Widget) {
+	C.infoBarRemoveChild(ib.infoBar(), child.GetWidget())
+}
+
+// SetRevealed shows or hides the info bar with a slide animation
+func (ib *InfoBar) SetRevealed(revealed bool) {
+	var crevealed C.gboolean
+	if revealed {
+		crevealed = C.TRUE
+	} else {
+		crevealed = C.FALSE
+	}
+	C.infoBarSetRevealed(ib.infoBar(), crevealed)
+}
+
+// GetRevealed returns whether the info bar is revealed
+func (ib *InfoBar) GetRevealed() bool {
+	return C.infoBarGetRevealed(ib.infoBar()) == C.TRUE
+}
+
+// SetShowCloseButton sets whether the info bar has a close button, which
+// emits ResponseClose. Pressing Escape also emits ResponseClose.
+func (ib *InfoBar) SetShowCloseButton(show bool) {
+	var cshow C.gboolean
+	if show {
+		cshow = C.TRUE
+	} else {
+		cshow = C.FALSE
+	}
+	C.infoBarSetShowCloseButton(ib.infoBar(), cshow)
+}
+
+// SetDefaultResponse sets the response emitted when Enter is pressed
+func (ib *InfoBar) SetDefaultResponse(responseId ResponseType) {
+	C.infoBarSetDefaultResponse(ib.infoBar(), C.int(responseId))
+}
+
+// ConnectResponse connects a callback for button clicks. The info bar is
+// not hidden automatically; call SetRevealed(false) to dismiss it.
+func (ib *InfoBar) ConnectResponse(callback func(responseId ResponseType)) uint64 {
+	return connectCustomSignal(ib, SignalInfoBarResponse, callback, func(id C.guint) C.gulong {
+		return C.connectInfoBarResponse(ib.infoBar(), id)
+	})
+}
+
+// Destroy destroys the info bar and cleans up resources
+func (ib *InfoBar) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(ib)
+
+	// Call base destroy method
+	ib.BaseWidget.Destroy()
+}
+
+//export infoBarResponseCallback
+func infoBarResponseCallback(infoBar *C.GtkInfoBar, responseId C.int, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	if cb, ok := callback.(func(ResponseType)); ok {
+		SafeCallback(cb, ResponseType(responseId))
+	} else {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"infoBarResponseCallback: callback has wrong type: %T", callback)
+	}
+}
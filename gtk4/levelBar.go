@@ -0,0 +1,168 @@
+// Package gtk4 provides level bar functionality for GTK4
+// File: gtk4go/gtk4/levelBar.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// LevelBarMode defines how a level bar displays its value
+type LevelBarMode int
+
+const (
+	// LevelBarModeContinuous draws the value as a single continuous block
+	LevelBarModeContinuous LevelBarMode = C.GTK_LEVEL_BAR_MODE_CONTINUOUS
+	// LevelBarModeDiscrete draws the value as a number of discrete blocks
+	LevelBarModeDiscrete LevelBarMode = C.GTK_LEVEL_BAR_MODE_DISCRETE
+)
+
+// Default offset names used by GTK level bars. The fill block of the level bar
+// gets a CSS class with the name of the lowest offset above the current value,
+// e.g. "levelbar block.filled.high".
+const (
+	LevelBarOffsetLow  = "low"
+	LevelBarOffsetHigh = "high"
+	LevelBarOffsetFull = "full"
+)
+
+// LevelBarOption is a function that configures a level bar
+type LevelBarOption func(*LevelBar)
+
+// LevelBar represents a GTK level bar, used to show a level such as
+// CPU, memory or disk usage
+type LevelBar struct {
+	BaseWidget
+}
+
+// NewLevelBar creates a new GTK level bar with a range of 0.0 to 1.0
+func NewLevelBar(options ...LevelBarOption) *LevelBar {
+	levelBar := &LevelBar{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_level_bar_new(),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(levelBar)
+	}
+
+	SetupFinalization(levelBar, levelBar.Destroy)
+	return levelBar
+}
+
+// WithLevelBarRange sets the minimum and maximum values of the level bar
+func WithLevelBarRange(min, max float64) LevelBarOption {
+	return func(lb *LevelBar) {
+		lb.SetMinValue(min)
+		lb.SetMaxValue(max)
+	}
+}
+
+// WithLevelBarMode sets the display mode of the level bar
+func WithLevelBarMode(mode LevelBarMode) LevelBarOption {
+	return func(lb *LevelBar) {
+		lb.SetMode(mode)
+	}
+}
+
+// WithLevelBarOffset adds an offset marker to the level bar
+func WithLevelBarOffset(name string, value float64) LevelBarOption {
+	return func(lb *LevelBar) {
+		lb.AddOffsetValue(name, value)
+	}
+}
+
+// SetValue sets the value of the level bar
+func (lb *LevelBar) SetValue(value float64) {
+	C.gtk_level_bar_set_value((*C.GtkLevelBar)(unsafe.Pointer(lb.widget)), C.double(value))
+}
+
+// GetValue gets the value of the level bar
+func (lb *LevelBar) GetValue() float64 {
+	return float64(C.gtk_level_bar_get_value((*C.GtkLevelBar)(unsafe.Pointer(lb.widget))))
+}
+
+// SetMinValue sets the minimum value of the level bar
+func (lb *LevelBar) SetMinValue(value float64) {
+	C.gtk_level_bar_set_min_value((*C.GtkLevelBar)(unsafe.Pointer(lb.widget)), C.double(value))
+}
+
+// GetMinValue gets the minimum value of the level bar
+func (lb *LevelBar) GetMinValue() float64 {
+	return float64(C.gtk_level_bar_get_min_value((*C.GtkLevelBar)(unsafe.Pointer(lb.widget))))
+}
+
+// SetMaxValue sets the maximum value of the level bar
+func (lb *LevelBar) SetMaxValue(value float64) {
+	C.gtk_level_bar_set_max_value((*C.GtkLevelBar)(unsafe.Pointer(lb.widget)), C.double(value))
+}
+
+// GetMaxValue gets the maximum value of the level bar
+func (lb *LevelBar) GetMaxValue() float64 {
+	return float64(C.gtk_level_bar_get_max_value((*C.GtkLevelBar)(unsafe.Pointer(lb.widget))))
+}
+
+// SetMode sets the display mode of the level bar
+func (lb *LevelBar) SetMode(mode LevelBarMode) {
+	C.gtk_level_bar_set_mode((*C.GtkLevelBar)(unsafe.Pointer(lb.widget)), C.GtkLevelBarMode(mode))
+}
+
+// GetMode gets the display mode of the level bar
+func (lb *LevelBar) GetMode() LevelBarMode {
+	return LevelBarMode(C.gtk_level_bar_get_mode((*C.GtkLevelBar)(unsafe.Pointer(lb.widget))))
+}
+
+// SetInverted sets whether the level bar fills from the opposite end
+func (lb *LevelBar) SetInverted(inverted bool) {
+	var cInverted C.gboolean
+	if inverted {
+		cInverted = C.TRUE
+	} else {
+		cInverted = C.FALSE
+	}
+	C.gtk_level_bar_set_inverted((*C.GtkLevelBar)(unsafe.Pointer(lb.widget)), cInverted)
+}
+
+// AddOffsetValue adds or updates a named offset marker. While the value is at
+// or below the offset, the fill block carries the offset name as a CSS class,
+// so thresholds can be styled with selectors like "levelbar block.high".
+// Adding an offset with an existing name replaces its value.
+func (lb *LevelBar) AddOffsetValue(name string, value float64) {
+	WithCString(name, func(cName *C.char) {
+		C.gtk_level_bar_add_offset_value((*C.GtkLevelBar)(unsafe.Pointer(lb.widget)), cName, C.double(value))
+	})
+}
+
+// RemoveOffsetValue removes a named offset marker
+func (lb *LevelBar) RemoveOffsetValue(name string) {
+	WithCString(name, func(cName *C.char) {
+		C.gtk_level_bar_remove_offset_value((*C.GtkLevelBar)(unsafe.Pointer(lb.widget)), cName)
+	})
+}
+
+// GetOffsetValue gets the value of a named offset marker
+func (lb *LevelBar) GetOffsetValue(name string) (float64, bool) {
+	var value C.double
+	var found C.gboolean
+
+	WithCString(name, func(cName *C.char) {
+		found = C.gtk_level_bar_get_offset_value((*C.GtkLevelBar)(unsafe.Pointer(lb.widget)), cName, &value)
+	})
+
+	return float64(value), found == C.TRUE
+}
+
+// Destroy destroys the level bar and cleans up resources
+func (lb *LevelBar) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(lb)
+
+	// Call base destroy method
+	lb.BaseWidget.Destroy()
+}
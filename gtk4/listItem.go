@@ -89,7 +89,9 @@ type ListItem struct {
 	listItem *C.GtkListItem
 }
 
-// GetChild returns the child widget of the list item
+// GetChild returns the child widget of the list item. The returned wrapper
+// only gives access to the generic widget methods; use FindLabel to update
+// the text of a label inside the child from a bind callback.
 func (li *ListItem) GetChild() Widget {
 	widget := C.listItemGetChild(li.listItem)
 	if widget == nil {
@@ -100,6 +102,22 @@ func (li *ListItem) GetChild() Widget {
 	return &BaseWidget{widget: widget}
 }
 
+// FindLabel returns the first label in the list item's child, which may be
+// the child itself or a label nested in a container such as a Box, or nil if
+// there is none. The returned wrapper doesn't own the label.
+func (li *ListItem) FindLabel() *Label {
+	child := C.listItemGetChild(li.listItem)
+	if child == nil {
+		return nil
+	}
+
+	label := C.findLabelInChild(child)
+	if label == nil {
+		return nil
+	}
+	return &Label{BaseWidget{widget: (*C.GtkWidget)(unsafe.Pointer(label))}}
+}
+
 // SetChild sets the child widget for the list item
 func (li *ListItem) SetChild(child Widget) {
 	if child == nil {
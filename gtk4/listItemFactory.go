@@ -6,31 +6,16 @@ package gtk4
 // #include <gtk/gtk.h>
 // #include <stdlib.h>
 //
-// // Signal list item factory callbacks
-// extern void setupListItemCallback(GtkSignalListItemFactory *factory, GtkListItem *list_item, gpointer user_data);
-// extern void bindListItemCallback(GtkSignalListItemFactory *factory, GtkListItem *list_item, gpointer user_data);
-// extern void unbindListItemCallback(GtkSignalListItemFactory *factory, GtkListItem *list_item, gpointer user_data);
-// extern void teardownListItemCallback(GtkSignalListItemFactory *factory, GtkListItem *list_item, gpointer user_data);
-//
-// // Connect signals for list item factory
+// // Create a signal list item factory
 // static GtkSignalListItemFactory* createSignalListItemFactory() {
 //     return (GtkSignalListItemFactory*)gtk_signal_list_item_factory_new();
 // }
 //
-// static gulong connectSetupListItem(GtkSignalListItemFactory *factory, gpointer user_data) {
-//     return g_signal_connect(factory, "setup", G_CALLBACK(setupListItemCallback), user_data);
-// }
-//
-// static gulong connectBindListItem(GtkSignalListItemFactory *factory, gpointer user_data) {
-//     return g_signal_connect(factory, "bind", G_CALLBACK(bindListItemCallback), user_data);
-// }
-//
-// static gulong connectUnbindListItem(GtkSignalListItemFactory *factory, gpointer user_data) {
-//     return g_signal_connect(factory, "unbind", G_CALLBACK(unbindListItemCallback), user_data);
-// }
-//
-// static gulong connectTeardownListItem(GtkSignalListItemFactory *factory, gpointer user_data) {
-//     return g_signal_connect(factory, "teardown", G_CALLBACK(teardownListItemCallback), user_data);
+// // Give a list item a start-aligned label as its child
+// static void listItemSetLabelChild(GtkListItem *list_item) {
+//     GtkWidget *label = gtk_label_new(NULL);
+//     gtk_label_set_xalign(GTK_LABEL(label), 0.0);
+//     gtk_list_item_set_child(list_item, label);
 // }
 import "C"
 
@@ -50,70 +35,6 @@ const (
 	SignalTeardown SignalType = "teardown"
 )
 
-//export setupListItemCallback
-func setupListItemCallback(factory *C.GtkSignalListItemFactory, listItem *C.GtkListItem, userData C.gpointer) {
-	// Get factory pointer for lookup in the unified callback system
-	factoryPtr := uintptr(unsafe.Pointer(factory))
-	
-	// Create a Go wrapper for the list item
-	goListItem := &ListItem{listItem: listItem}
-	
-	// Find callback using the unified callback system
-	if callback := GetCallback(factoryPtr, SignalSetup); callback != nil {
-		// The modified SafeCallback function in callbacks.go now handles ListItemCallback
-		SafeCallback(callback, goListItem)
-	}
-}
-
-//export bindListItemCallback
-func bindListItemCallback(factory *C.GtkSignalListItemFactory, listItem *C.GtkListItem, userData C.gpointer) {
-	// Get factory pointer for lookup in the unified callback system
-	factoryPtr := uintptr(unsafe.Pointer(factory))
-	
-	// Create a Go wrapper for the list item
-	goListItem := &ListItem{listItem: listItem}
-	
-	// Find callback using the unified callback system
-	if callback := GetCallback(factoryPtr, SignalBind); callback != nil {
-		// The modified SafeCallback function in callbacks.go now handles ListItemCallback
-		SafeCallback(callback, goListItem)
-	} else {
-		// If no callback is registered, try a default implementation
-		// that just sets the text on the child label
-		goListItem.UpdateChildWithText()
-	}
-}
-
-//export unbindListItemCallback
-func unbindListItemCallback(factory *C.GtkSignalListItemFactory, listItem *C.GtkListItem, userData C.gpointer) {
-	// Get factory pointer for lookup in the unified callback system
-	factoryPtr := uintptr(unsafe.Pointer(factory))
-	
-	// Create a Go wrapper for the list item
-	goListItem := &ListItem{listItem: listItem}
-	
-	// Find callback using the unified callback system
-	if callback := GetCallback(factoryPtr, SignalUnbind); callback != nil {
-		// The modified SafeCallback function in callbacks.go now handles ListItemCallback
-		SafeCallback(callback, goListItem)
-	}
-}
-
-//export teardownListItemCallback
-func teardownListItemCallback(factory *C.GtkSignalListItemFactory, listItem *C.GtkListItem, userData C.gpointer) {
-	// Get factory pointer for lookup in the unified callback system
-	factoryPtr := uintptr(unsafe.Pointer(factory))
-	
-	// Create a Go wrapper for the list item
-	goListItem := &ListItem{listItem: listItem}
-	
-	// Find callback using the unified callback system
-	if callback := GetCallback(factoryPtr, SignalTeardown); callback != nil {
-		// The modified SafeCallback function in callbacks.go now handles ListItemCallback
-		SafeCallback(callback, goListItem)
-	}
-}
-
 // ListItemFactory is an interface for factories that create list items
 type ListItemFactory interface {
 	// GetListItemFactory returns the underlying GtkListItemFactory pointer
@@ -143,44 +64,88 @@ func (f *SignalListItemFactory) GetListItemFactory() *C.GtkListItemFactory {
 	return (*C.GtkListItemFactory)(unsafe.Pointer(f.factory))
 }
 
-// ConnectSetup connects a callback for the setup signal
-func (f *SignalListItemFactory) ConnectSetup(callback ListItemCallback) {
+// Native returns the underlying GtkSignalListItemFactory pointer as uintptr
+func (f *SignalListItemFactory) Native() uintptr {
+	return uintptr(unsafe.Pointer(f.factory))
+}
+
+// ConnectSetup connects a callback for the setup signal, emitted when a list
+// item is created. Build the row's widgets here and set them as the child.
+// Like the other factory callbacks, it is called by callbackHandlerWithParam,
+// which wraps the GtkListItem, and runs during the signal emission, as the
+// list item is only valid until the emission returns.
+// The returned ID can be used with Disconnect.
+func (f *SignalListItemFactory) ConnectSetup(callback ListItemCallback) uint64 {
 	if callback == nil {
-		return
+		return 0
 	}
 
-	// Use the standard Connect function with the correct GTK signal name
-	Connect(f, SignalSetup, callback)
+	return Connect(f, SignalSetup, callback)
 }
 
-// ConnectBind connects a callback for the bind signal
-func (f *SignalListItemFactory) ConnectBind(callback ListItemCallback) {
+// ConnectBind connects a callback for the bind signal, emitted when a list
+// item is assigned a model item. Update the row's widgets from GetItem here.
+// The returned ID can be used with Disconnect.
+func (f *SignalListItemFactory) ConnectBind(callback ListItemCallback) uint64 {
 	if callback == nil {
-		return
+		return 0
 	}
 
-	// Use the standard Connect function with the correct GTK signal name
-	Connect(f, SignalBind, callback)
+	return Connect(f, SignalBind, callback)
 }
 
-// ConnectUnbind connects a callback for the unbind signal
-func (f *SignalListItemFactory) ConnectUnbind(callback ListItemCallback) {
+// ConnectUnbind connects a callback for the unbind signal, emitted when a
+// list item is released from its model item before being reused for another.
+// Reset per-item state here so recycled rows don't show stale data.
+// The returned ID can be used with Disconnect.
+func (f *SignalListItemFactory) ConnectUnbind(callback ListItemCallback) uint64 {
 	if callback == nil {
-		return
+		return 0
 	}
 
-	// Use the standard Connect function with the correct GTK signal name
-	Connect(f, SignalUnbind, callback)
+	return Connect(f, SignalUnbind, callback)
 }
 
-// ConnectTeardown connects a callback for the teardown signal
-func (f *SignalListItemFactory) ConnectTeardown(callback ListItemCallback) {
+// ConnectTeardown connects a callback for the teardown signal, emitted before
+// a list item is destroyed. Release per-row resources created during setup,
+// such as event controllers, here. The returned ID can be used with Disconnect.
+func (f *SignalListItemFactory) ConnectTeardown(callback ListItemCallback) uint64 {
 	if callback == nil {
+		return 0
+	}
+
+	return Connect(f, SignalTeardown, callback)
+}
+
+// BindLabel connects callbacks that show each item as a label whose text is
+// computed from the model item (as returned by ListItem.GetItem). Items
+// without a child get a label during setup; otherwise the first label inside
+// the child set up by another setup callback is used. The text is cleared on
+// unbind so that recycled rows never show a stale value.
+func (f *SignalListItemFactory) BindLabel(text func(item interface{}) string) {
+	if text == nil {
 		return
 	}
 
-	// Use the standard Connect function with the correct GTK signal name
-	Connect(f, SignalTeardown, callback)
+	f.ConnectSetup(func(listItem *ListItem) {
+		// The label is created in C so that no Go wrapper finalizer can
+		// unparent it while the list item still uses it
+		if C.gtk_list_item_get_child(listItem.listItem) == nil {
+			C.listItemSetLabelChild(listItem.listItem)
+		}
+	})
+
+	f.ConnectBind(func(listItem *ListItem) {
+		if label := listItem.FindLabel(); label != nil {
+			label.SetText(text(listItem.GetItem()))
+		}
+	})
+
+	f.ConnectUnbind(func(listItem *ListItem) {
+		if label := listItem.FindLabel(); label != nil {
+			label.SetText("")
+		}
+	})
 }
 
 // DisconnectSetup disconnects the setup signal callback
@@ -27,6 +27,10 @@ package gtk4
 //     gtk_string_list_remove(list, position);
 // }
 //
+// static void stringListSplice(GtkStringList *list, guint position, guint n_removals, char **additions) {
+//     gtk_string_list_splice(list, position, n_removals, (const char * const *)additions);
+// }
+//
 // static guint stringListGetNItems(GtkStringList *list) {
 //     return g_list_model_get_n_items(G_LIST_MODEL(list));
 // }
@@ -54,6 +58,37 @@ package gtk4
 //     g_list_store_remove(store, position);
 // }
 //
+// static void listStoreInsert(GListStore *store, guint position, gpointer item) {
+//     g_list_store_insert(store, position, item);
+// }
+//
+// static void listStoreRemoveAll(GListStore *store) {
+//     g_list_store_remove_all(store);
+// }
+//
+// // Insert a string as a GtkStringObject; the store holds the only reference
+// static void listStoreInsertString(GListStore *store, guint position, const char *string) {
+//     GtkStringObject *obj = gtk_string_object_new(string);
+//     g_list_store_insert(store, position, obj);
+//     g_object_unref(obj);
+// }
+//
+// static GType listStoreStringType() {
+//     return GTK_TYPE_STRING_OBJECT;
+// }
+//
+// static gboolean listStoreIsObjectType(GType type) {
+//     return g_type_is_a(type, G_TYPE_OBJECT);
+// }
+//
+// static gboolean listStoreAcceptsStrings(GType type) {
+//     return g_type_is_a(GTK_TYPE_STRING_OBJECT, type);
+// }
+//
+// static gboolean listStoreAcceptsItem(GType type, gpointer item) {
+//     return G_IS_OBJECT(item) && G_TYPE_CHECK_INSTANCE_TYPE(item, type);
+// }
+//
 // static gpointer listModelGetItem(GListModel *model, guint position) {
 //     return g_list_model_get_item(model, position);
 // }
@@ -64,6 +99,7 @@ package gtk4
 import "C"
 
 import (
+	"fmt"
 	"runtime"
 	"unsafe"
 )
@@ -124,6 +160,11 @@ func (m *BaseListModel) GetListModel() *C.GListModel {
 	return m.model
 }
 
+// Native returns the underlying GListModel pointer as uintptr
+func (m *BaseListModel) Native() uintptr {
+	return uintptr(unsafe.Pointer(m.model))
+}
+
 // GetNItems returns the number of items in the model
 func (m *BaseListModel) GetNItems() int {
 	return int(C.listModelGetNItems(m.model))
@@ -205,8 +246,50 @@ func NewStringList() *StringList {
 	return list
 }
 
+// NewStringListFromSlice creates a new string list containing the given strings
+func NewStringListFromSlice(strs []string) *StringList {
+	list := NewStringList()
+	if len(strs) > 0 {
+		list.Splice(0, 0, strs)
+	}
+	return list
+}
+
+// Splice removes nRemovals strings at position and inserts additions in
+// their place, emitting a single items-changed signal. Returns an error if
+// the range to remove is outside the list.
+func (l *StringList) Splice(position, nRemovals int, additions []string) error {
+	nItems := l.GetNItems()
+	if position < 0 || nRemovals < 0 || position > nItems || nRemovals > nItems-position {
+		return &GTKError{Op: "StringList.Splice",
+			Err: fmt.Errorf("cannot remove %d items at position %d from a list of %d", nRemovals, position, nItems)}
+	}
+
+	cAdditions := make([]*C.char, len(additions)+1)
+	for i, str := range additions {
+		cAdditions[i] = C.CString(str)
+		defer C.free(unsafe.Pointer(cAdditions[i]))
+	}
+
+	C.stringListSplice(l.stringList, C.guint(position), C.guint(nRemovals), &cAdditions[0])
+	return nil
+}
+
+// Clear removes all strings from the list. Clearing an empty list is a no-op.
+func (l *StringList) Clear() {
+	if nItems := l.GetNItems(); nItems > 0 {
+		l.Splice(0, nItems, nil)
+	}
+}
+
 // Append adds a string to the list
 func (l *StringList) Append(str string) {
+	if l.stringList == nil {
+		DebugLog(DebugLevelError, DebugComponentModel, "StringList.Append: list has been destroyed")
+		return
+	}
+	DebugLog(DebugLevelVerbose, DebugComponentModel, "StringList.Append: appending %q", str)
+
 	cStr := C.CString(str)
 	defer C.free(unsafe.Pointer(cStr))
 	C.stringListAppend(l.stringList, cStr)
@@ -214,9 +297,17 @@ func (l *StringList) Append(str string) {
 
 // Remove removes a string from the list at the given position
 func (l *StringList) Remove(position int) {
-	if position >= 0 && position < l.GetNItems() {
-		C.stringListRemove(l.stringList, C.guint(position))
+	if l.stringList == nil {
+		DebugLog(DebugLevelError, DebugComponentModel, "StringList.Remove: list has been destroyed")
+		return
 	}
+	if position < 0 || position >= l.GetNItems() {
+		DebugLog(DebugLevelWarning, DebugComponentModel, "StringList.Remove: position %d out of range for %d items", position, l.GetNItems())
+		return
+	}
+
+	DebugLog(DebugLevelVerbose, DebugComponentModel, "StringList.Remove: removing item at position %d", position)
+	C.stringListRemove(l.stringList, C.guint(position))
 }
 
 // GetString returns the string at the given position
@@ -249,7 +340,11 @@ func (l *StringList) Destroy() {
 	l.stringList = nil
 }
 
-// ListStore is a generic list store
+// ListStore is a list model of GObjects, backed by a GListStore. Strings are
+// stored as GtkStringObjects and other items must be GObjects of the
+// store's item type, given as wrappers such as widgets or other Objects.
+// The store keeps the Go values it was given, so GetItem returns them and
+// their wrappers stay alive while they are in the store.
 type ListStore struct {
 	BaseListModel
 	listStore *C.GListStore
@@ -257,8 +352,19 @@ type ListStore struct {
 	items     []interface{} // Keep Go references to items
 }
 
-// NewListStore creates a new list store with the given item type
+// NewListStore creates a new list store with the given item type, which
+// must be G_TYPE_OBJECT or a subtype of it. G_TYPE_STRING is accepted and
+// stores strings as GtkStringObjects; any other type falls back to
+// G_TYPE_OBJECT and logs an error.
 func NewListStore(itemType C.GType) *ListStore {
+	if itemType == C.G_TYPE_STRING {
+		itemType = C.listStoreStringType()
+	} else if C.listStoreIsObjectType(itemType) == C.FALSE {
+		DebugLog(DebugLevelError, DebugComponentModel,
+			"NewListStore: item type %s is not an object type, using GObject", C.GoString(C.g_type_name(itemType)))
+		itemType = C.G_TYPE_OBJECT
+	}
+
 	listStore := C.createListStore(itemType)
 	store := &ListStore{
 		BaseListModel: BaseListModel{
@@ -273,30 +379,97 @@ func NewListStore(itemType C.GType) *ListStore {
 	return store
 }
 
-// Append adds an item to the list store
-// Note: The implementation of this method depends on the type of items stored
-// and would need customization for practical use
-func (s *ListStore) Append(item interface{}) {
-	// This is a simplified implementation that would need to be adapted
-	// based on the actual item types being stored
-	var cItem C.gpointer
-	// Convert item to appropriate C pointer based on type
-	// (Implementation would depend on the actual item types)
-
-	C.listStoreAppend(s.listStore, cItem)
-	s.items = append(s.items, item) // Store Go reference
+// NewStringListStore creates a new list store of strings
+func NewStringListStore() *ListStore {
+	return NewListStore(C.listStoreStringType())
+}
+
+// NewObjectListStore creates a new list store that accepts any GObject
+func NewObjectListStore() *ListStore {
+	return NewListStore(C.G_TYPE_OBJECT)
+}
+
+// Append adds an item at the end of the list store. The item must be a
+// string for a store of strings, or an Object of the store's item type.
+func (s *ListStore) Append(item interface{}) error {
+	return s.Insert(len(s.items), item)
+}
+
+// Insert adds an item at the given position; see Append for the items a
+// store accepts
+func (s *ListStore) Insert(position int, item interface{}) error {
+	if s.listStore == nil {
+		DebugLog(DebugLevelError, DebugComponentModel, "ListStore.Insert: store has been destroyed")
+		return &GTKError{Op: "ListStore.Insert", Err: fmt.Errorf("store has been destroyed")}
+	}
+	if position < 0 || position > len(s.items) {
+		return &GTKError{Op: "ListStore.Insert",
+			Err: fmt.Errorf("position %d out of range for %d items", position, len(s.items))}
+	}
+
+	switch v := item.(type) {
+	case string:
+		if C.listStoreAcceptsStrings(s.itemType) == C.FALSE {
+			return s.rejectItem(item)
+		}
+		cStr := C.CString(v)
+		defer C.free(unsafe.Pointer(cStr))
+		C.listStoreInsertString(s.listStore, C.guint(position), cStr)
+	case Object:
+		ptr := C.gpointer(unsafe.Pointer(v.Native()))
+		if ptr == nil || C.listStoreAcceptsItem(s.itemType, ptr) == C.FALSE {
+			return s.rejectItem(item)
+		}
+		// The store takes its own reference to the object
+		C.listStoreInsert(s.listStore, C.guint(position), ptr)
+	default:
+		return s.rejectItem(item)
+	}
+
+	DebugLog(DebugLevelVerbose, DebugComponentModel, "ListStore.Insert: inserted %T at position %d", item, position)
+	s.items = append(s.items, nil)
+	copy(s.items[position+1:], s.items[position:])
+	s.items[position] = item
+	return nil
+}
+
+// rejectItem logs and returns the error for an item of the wrong type
+func (s *ListStore) rejectItem(item interface{}) error {
+	err := &GTKError{Op: "ListStore.Insert",
+		Err: fmt.Errorf("%T is not a valid item for a store of %s", item, C.GoString(C.g_type_name(s.itemType)))}
+	DebugLog(DebugLevelError, DebugComponentModel, "%v", err)
+	return err
 }
 
 // Remove removes an item from the list store at the given position
 func (s *ListStore) Remove(position int) {
-	if position >= 0 && position < len(s.items) {
-		C.listStoreRemove(s.listStore, C.guint(position))
-		// Remove the Go reference
-		s.items = append(s.items[:position], s.items[position+1:]...)
+	if s.listStore == nil {
+		DebugLog(DebugLevelError, DebugComponentModel, "ListStore.Remove: store has been destroyed")
+		return
 	}
+	if position < 0 || position >= len(s.items) {
+		DebugLog(DebugLevelWarning, DebugComponentModel, "ListStore.Remove: position %d out of range for %d items", position, len(s.items))
+		return
+	}
+
+	DebugLog(DebugLevelVerbose, DebugComponentModel, "ListStore.Remove: removing item at position %d", position)
+	C.listStoreRemove(s.listStore, C.guint(position))
+	// Remove the Go reference
+	s.items = append(s.items[:position], s.items[position+1:]...)
 }
 
-// GetItem returns the item at the given position
+// RemoveAll removes all items from the list store
+func (s *ListStore) RemoveAll() {
+	if s.listStore == nil || len(s.items) == 0 {
+		return
+	}
+
+	DebugLog(DebugLevelVerbose, DebugComponentModel, "ListStore.RemoveAll: removing %d items", len(s.items))
+	C.listStoreRemoveAll(s.listStore)
+	s.items = s.items[:0]
+}
+
+// GetItem returns the item at the given position, as it was added
 func (s *ListStore) GetItem(position int) interface{} {
 	if position < 0 || position >= len(s.items) {
 		return nil
@@ -304,9 +477,16 @@ func (s *ListStore) GetItem(position int) interface{} {
 	return s.items[position]
 }
 
-// Destroy frees resources associated with the list store
+// Destroy frees resources associated with the list store. Calling it again,
+// or letting the finalizer run afterwards, has no effect.
 func (s *ListStore) Destroy() {
+	if s.listStore == nil {
+		return
+	}
+
+	DebugLog(DebugLevelVerbose, DebugComponentModel, "ListStore.Destroy: freeing store with %d items", len(s.items))
+	runtime.SetFinalizer(s, nil)
 	s.BaseListModel.Destroy()
 	s.listStore = nil
 	s.items = nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,145 @@
+package gtk4
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/justyntemme/gtk4go"
+)
+
+// onUIThread runs fn on the UI thread, failing the test if the main loop
+// doesn't run it in time. fn runs on the main goroutine, so it must report
+// failures with t.Error rather than t.Fatal.
+func onUIThread(t *testing.T, fn func()) {
+	t.Helper()
+	if err := gtk4go.RunOnUIThreadTimeout(fn, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// storeItems returns the Go items of a list store, checking that the
+// native store has the same number of items
+func storeItems(t *testing.T, store *ListStore) []interface{} {
+	t.Helper()
+	items := []interface{}{}
+	for i := 0; i < len(store.items); i++ {
+		items = append(items, store.GetItem(i))
+	}
+	if n := store.GetNItems(); n != len(items) {
+		t.Errorf("native store has %d items, Go side has %d", n, len(items))
+	}
+	return items
+}
+
+func TestListStoreStrings(t *testing.T) {
+	onUIThread(t, func() {
+		store := NewStringListStore()
+		defer store.Destroy()
+
+		for _, s := range []string{"a", "c"} {
+			if err := store.Append(s); err != nil {
+				t.Errorf("Append(%q): %v", s, err)
+			}
+		}
+		if err := store.Insert(1, "b"); err != nil {
+			t.Errorf("Insert: %v", err)
+		}
+		if got, want := storeItems(t, store), []interface{}{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("items = %v, want %v", got, want)
+		}
+
+		store.Remove(0)
+		store.Remove(5) // Out of range, ignored
+		if got, want := storeItems(t, store), []interface{}{"b", "c"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("items after Remove = %v, want %v", got, want)
+		}
+
+		// A store of strings only accepts strings
+		label := NewLabel("label")
+		if err := store.Append(label); err == nil {
+			t.Error("store of strings accepted a label")
+		}
+		if err := store.Append(3); err == nil {
+			t.Error("store of strings accepted an int")
+		}
+		if err := store.Insert(5, "x"); err == nil {
+			t.Error("Insert accepted a position out of range")
+		}
+
+		store.RemoveAll()
+		if got := storeItems(t, store); len(got) != 0 {
+			t.Errorf("items after RemoveAll = %v, want none", got)
+		}
+	})
+}
+
+func TestListStoreObjects(t *testing.T) {
+	onUIThread(t, func() {
+		store := NewObjectListStore()
+		label := NewLabel("label")
+		button := NewButton("button")
+
+		if err := store.Append(label); err != nil {
+			t.Errorf("Append(label): %v", err)
+		}
+		if err := store.Append("text"); err != nil {
+			t.Errorf("Append(string): %v", err)
+		}
+		if err := store.Append(button); err != nil {
+			t.Errorf("Append(button): %v", err)
+		}
+
+		if got, want := storeItems(t, store), []interface{}{label, "text", button}; !reflect.DeepEqual(got, want) {
+			t.Errorf("items = %v, want %v", got, want)
+		}
+		// The native store holds the objects that were appended
+		if got := store.BaseListModel.GetItem(0); got != label.Native() {
+			t.Errorf("native item 0 = %#x, want the label %#x", got, label.Native())
+		}
+
+		store.Remove(1)
+		if got, want := storeItems(t, store), []interface{}{label, button}; !reflect.DeepEqual(got, want) {
+			t.Errorf("items after Remove = %v, want %v", got, want)
+		}
+
+		// Destroying twice, as the finalizer would, is a no-op
+		store.Destroy()
+		store.Destroy()
+		if err := store.Append("late"); err == nil {
+			t.Error("destroyed store accepted an item")
+		}
+		if n := len(store.items); n != 0 {
+			t.Errorf("destroyed store keeps %d items", n)
+		}
+	})
+}
+
+func TestStringListSplice(t *testing.T) {
+	onUIThread(t, func() {
+		list := NewStringListFromSlice([]string{"a", "b", "c", "d"})
+		defer list.Destroy()
+
+		if err := list.Splice(1, 2, []string{"x", "y", "z"}); err != nil {
+			t.Errorf("Splice: %v", err)
+			return
+		}
+		list.Append("e")
+		list.Remove(0)
+
+		var got []string
+		for i := 0; i < list.GetNItems(); i++ {
+			got = append(got, list.GetString(i))
+		}
+		if want := []string{"x", "y", "z", "d", "e"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("strings = %v, want %v", got, want)
+		}
+
+		if err := list.Splice(4, 2, nil); err == nil {
+			t.Error("Splice accepted a range past the end of the list")
+		}
+		if err := list.Splice(-1, 0, []string{"w"}); err == nil {
+			t.Error("Splice accepted a negative position")
+		}
+	})
+}
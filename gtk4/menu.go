@@ -12,6 +12,34 @@ package gtk4
 //     return item;
 // }
 //
+// // Set or clear the accelerator shown next to a menu item
+// static void menu_item_set_accel(GMenuItem* item, const char* accel) {
+//     if (accel == NULL) {
+//         g_menu_item_set_attribute_value(item, "accel", NULL);
+//     } else {
+//         g_menu_item_set_attribute(item, "accel", "s", accel);
+//     }
+// }
+//
+// // Set or clear the icon of a menu item from a themed icon name
+// static void menu_item_set_icon_name(GMenuItem* item, const char* icon_name) {
+//     if (icon_name == NULL) {
+//         g_menu_item_set_icon(item, NULL);
+//         return;
+//     }
+//     GIcon* icon = g_themed_icon_new(icon_name);
+//     g_menu_item_set_icon(item, icon);
+//     g_object_unref(icon);
+// }
+//
+// // Create a menu item that is a placeholder for a custom widget, added to
+// // a popover menu with gtk_popover_menu_add_child under the same id
+// static GMenuItem* create_custom_menu_item(const char* id) {
+//     GMenuItem* item = g_menu_item_new(NULL, NULL);
+//     g_menu_item_set_attribute(item, "custom", "s", id);
+//     return item;
+// }
+//
 // static GMenu* create_menu() {
 //     return g_menu_new();
 // }
@@ -24,6 +52,27 @@ package gtk4
 //     g_menu_append_submenu(menu, label, G_MENU_MODEL(submenu));
 // }
 //
+// static void insert_item_in_menu(GMenu* menu, int position, GMenuItem* item) {
+//     g_menu_insert_item(menu, position, item);
+// }
+//
+// static void remove_item_from_menu(GMenu* menu, int position) {
+//     g_menu_remove(menu, position);
+// }
+//
+// static void remove_all_from_menu(GMenu* menu) {
+//     g_menu_remove_all(menu);
+// }
+//
+// // Append a section; a NULL label gives an unlabeled section
+// static void append_section_to_menu(GMenu* menu, const char* label, GMenu* section) {
+//     g_menu_append_section(menu, label, G_MENU_MODEL(section));
+// }
+//
+// static int get_menu_n_items(GMenu* menu) {
+//     return g_menu_model_get_n_items(G_MENU_MODEL(menu));
+// }
+//
 // // PopoverMenu helper functions
 // static GtkWidget* create_popover_menu_from_model(GMenuModel* model) {
 //     return gtk_popover_menu_new_from_model(model);
@@ -37,6 +86,25 @@ package gtk4
 // static void set_popover_parent(GtkPopover* popover, GtkWidget* parent) {
 //     gtk_widget_set_parent(GTK_WIDGET(popover), parent);
 // }
+//
+// // Point a popover at a single point of its parent
+// static void set_popover_pointing_to_point(GtkPopover* popover, int x, int y) {
+//     GdkRectangle rect = { x, y, 1, 1 };
+//     gtk_popover_set_pointing_to(popover, &rect);
+// }
+//
+// // Unparent a widget from an idle callback, so that it is not removed
+// // while one of its own signals is being emitted
+// static gboolean unparent_widget_idle(gpointer data) {
+//     gtk_widget_unparent(GTK_WIDGET(data));
+//     g_object_unref(data);
+//     return G_SOURCE_REMOVE;
+// }
+//
+// static void unparent_widget_later(GtkWidget* widget) {
+//     g_object_ref(widget);
+//     g_idle_add(unparent_widget_idle, widget);
+// }
 import "C"
 
 import (
@@ -47,6 +115,9 @@ import (
 const (
     // For menu components
     SignalDeactivate SignalType = "deactivate"
+
+    // For popovers
+    SignalClosed SignalType = "closed"
 )
 
 // MenuItem represents a menu item
@@ -71,6 +142,86 @@ func NewMenuItem(label, action string) *MenuItem {
     }
 }
 
+// NewMenuItemWithIcon creates a new menu item with an icon from the icon theme.
+// GTK's popover menus only show icons of items displayed as buttons, e.g.
+// in sections with the "horizontal-buttons" display hint.
+func NewMenuItemWithIcon(label, action, iconName string) *MenuItem {
+    item := NewMenuItem(label, action)
+    item.SetIconName(iconName)
+    return item
+}
+
+// NewCheckMenuItem creates a new menu item shown with a check mark. The
+// action must be a boolean stateful action (see NewStatefulAction): the
+// check mark follows the action's state, and selecting the item toggles it.
+// The item is otherwise the same as one created with NewMenuItem.
+func NewCheckMenuItem(label, action string) *MenuItem {
+    return NewMenuItem(label, action)
+}
+
+// NewMenuItemWithTarget creates a new menu item that activates the action with
+// a target value, which is passed to the handler of a parameterized action
+// (see NewParameterizedAction). The target must have a type supported by
+// NewStatefulAction; an error is returned if it doesn't.
+func NewMenuItemWithTarget(label, action string, target interface{}) (*MenuItem, error) {
+    cTarget, err := variantFromValue(target)
+    if err != nil {
+        return nil, err
+    }
+
+    cLabel := C.CString(label)
+    defer C.free(unsafe.Pointer(cLabel))
+
+    cAction := C.CString(action)
+    defer C.free(unsafe.Pointer(cAction))
+
+    item := C.g_menu_item_new(cLabel, nil)
+    // The item takes ownership of the floating target
+    C.g_menu_item_set_action_and_target_value(item, cAction, cTarget)
+
+    return &MenuItem{
+        item: item,
+        name: action,
+    }, nil
+}
+
+// NewRadioMenuItem creates a new menu item shown as a radio button. The
+// action must be a stateful action whose state has the target's type (see
+// NewStatefulAction): the item is checked while the state equals the target,
+// and selecting it sets the state to the target. Items sharing an action
+// form a group, e.g. "Dark" and "Light" targets of a "theme" action.
+// An error is returned if the target has an unsupported type.
+func NewRadioMenuItem(label, action string, target interface{}) (*MenuItem, error) {
+    return NewMenuItemWithTarget(label, action, target)
+}
+
+// SetIconName sets the icon of the menu item from the icon theme.
+// An empty string removes it. Call this before AppendItem.
+func (mi *MenuItem) SetIconName(iconName string) {
+    if iconName == "" {
+        C.menu_item_set_icon_name(mi.item, nil)
+        return
+    }
+
+    cIconName := C.CString(iconName)
+    defer C.free(unsafe.Pointer(cIconName))
+
+    C.menu_item_set_icon_name(mi.item, cIconName)
+}
+
+// NewCustomMenuItem creates a placeholder item for a custom widget, such as a
+// slider. The widget is shown in its place once it is added to a popover
+// menu of the menu with PopoverMenu.AddChild under the same id.
+func NewCustomMenuItem(id string) *MenuItem {
+    cID := C.CString(id)
+    defer C.free(unsafe.Pointer(cID))
+
+    return &MenuItem{
+        item: C.create_custom_menu_item(cID),
+        name: id,
+    }
+}
+
 // GetNative returns the underlying GMenuItem pointer
 func (mi *MenuItem) GetNative() *C.GMenuItem {
     return mi.item
@@ -81,6 +232,30 @@ func (mi *MenuItem) GetName() string {
     return mi.name
 }
 
+// SetAccel sets the keyboard shortcut displayed next to the menu item,
+// in the format used by Application.SetAccelsForAction (e.g. "<Control>o").
+// An empty string removes it. This only changes what is displayed; the
+// shortcut itself must be bound with Application.SetAccelsForAction, which
+// also makes menus show the accelerator without calling SetAccel.
+// Menus copy items when they are appended, so call this before AppendItem.
+func (mi *MenuItem) SetAccel(accel string) {
+    if accel == "" {
+        C.menu_item_set_accel(mi.item, nil)
+        return
+    }
+
+    if !IsValidAccelerator(accel) {
+        DebugLog(DebugLevelWarning, DebugComponentGeneral,
+            "SetAccel: invalid accelerator %q for menu item %s", accel, mi.name)
+        return
+    }
+
+    cAccel := C.CString(accel)
+    defer C.free(unsafe.Pointer(cAccel))
+
+    C.menu_item_set_accel(mi.item, cAccel)
+}
+
 // Menu represents a GTK menu
 type Menu struct {
     menu *C.GMenu
@@ -99,7 +274,9 @@ func (m *Menu) AppendItem(item *MenuItem) {
     C.append_item_to_menu(m.menu, item.item)
 }
 
-// AppendSubmenu adds a submenu to the menu
+// AppendSubmenu adds a submenu to the menu. Popover menus show the submenu
+// by sliding it in, with a button to go back to the parent menu, and
+// submenus can be nested.
 func (m *Menu) AppendSubmenu(label string, submenu *Menu) {
     cLabel := C.CString(label)
     defer C.free(unsafe.Pointer(cLabel))
@@ -107,6 +284,51 @@ func (m *Menu) AppendSubmenu(label string, submenu *Menu) {
     C.append_submenu_to_menu(m.menu, cLabel, submenu.menu)
 }
 
+// InsertItem inserts a menu item at the given position. A position past the
+// end, or a negative one, appends the item.
+func (m *Menu) InsertItem(position int, item *MenuItem) {
+    if position < 0 || position > m.GetNItems() {
+        position = -1
+    }
+    C.insert_item_in_menu(m.menu, C.int(position), item.item)
+}
+
+// RemoveItem removes the item at the given position. Positions outside the
+// menu, e.g. any position of an empty menu, are ignored.
+func (m *Menu) RemoveItem(position int) {
+    if position < 0 || position >= m.GetNItems() {
+        return
+    }
+    C.remove_item_from_menu(m.menu, C.int(position))
+}
+
+// RemoveAll removes all items from the menu
+func (m *Menu) RemoveAll() {
+    C.remove_all_from_menu(m.menu)
+}
+
+// AppendSection adds the items of another menu as a section. Popover menus
+// and menu bars separate sections from the surrounding items with
+// separators, and show the label, if not empty, as the section's heading.
+// Later changes to the section menu are reflected in this menu.
+func (m *Menu) AppendSection(label string, section *Menu) {
+    if label == "" {
+        C.append_section_to_menu(m.menu, nil, section.menu)
+        return
+    }
+
+    cLabel := C.CString(label)
+    defer C.free(unsafe.Pointer(cLabel))
+
+    C.append_section_to_menu(m.menu, cLabel, section.menu)
+}
+
+// GetNItems returns the number of items in the menu, counting each
+// submenu and section as one item
+func (m *Menu) GetNItems() int {
+    return int(C.get_menu_n_items(m.menu))
+}
+
 // GetMenuModel returns the underlying GMenuModel
 func (m *Menu) GetMenuModel() *C.GMenuModel {
     return (*C.GMenuModel)(unsafe.Pointer(m.menu))
@@ -117,6 +339,11 @@ func (m *Menu) GetNative() uintptr {
     return uintptr(unsafe.Pointer(m.menu))
 }
 
+// Native returns the underlying GMenu pointer as uintptr
+func (m *Menu) Native() uintptr {
+    return uintptr(unsafe.Pointer(m.menu))
+}
+
 // MenuBar represents a GTK menu bar
 type MenuBar struct {
     BaseWidget
@@ -150,6 +377,7 @@ func (mb *MenuBar) ConnectSelectionChanged(callback func()) {
 // PopoverMenu represents a GTK popover menu
 type PopoverMenu struct {
     BaseWidget
+    customChildren []Widget // Keeps the wrappers of custom children alive
 }
 
 // NewPopoverMenu creates a new GTK popover menu from a menu model
@@ -172,6 +400,53 @@ func (pm *PopoverMenu) SetMenuModel(menu *Menu) {
     )
 }
 
+// AddChild adds a custom widget in place of the menu's custom item with the
+// given id (see NewCustomMenuItem), including items in submenus and
+// sections. Returns false if the menu has no such item. The popover keeps
+// the child until it is removed or the popover is destroyed, which also
+// releases the child's callbacks.
+func (pm *PopoverMenu) AddChild(child Widget, id string) bool {
+    cID := C.CString(id)
+    defer C.free(unsafe.Pointer(cID))
+
+    added := C.gtk_popover_menu_add_child(
+        (*C.GtkPopoverMenu)(unsafe.Pointer(pm.widget)),
+        child.GetWidget(),
+        cID,
+    ) == C.TRUE
+    if added {
+        pm.customChildren = append(pm.customChildren, child)
+    }
+    return added
+}
+
+// RemoveChild removes a custom widget added with AddChild
+func (pm *PopoverMenu) RemoveChild(child Widget) {
+    for i, c := range pm.customChildren {
+        if c.GetWidget() != child.GetWidget() {
+            continue
+        }
+
+        C.gtk_popover_menu_remove_child(
+            (*C.GtkPopoverMenu)(unsafe.Pointer(pm.widget)),
+            child.GetWidget(),
+        )
+        pm.customChildren = append(pm.customChildren[:i], pm.customChildren[i+1:]...)
+        return
+    }
+}
+
+// releaseCustomChildren releases the callbacks and data of the custom
+// children, which GTK destroys together with the popover
+func (pm *PopoverMenu) releaseCustomChildren() {
+    for _, child := range pm.customChildren {
+        if widget := child.GetWidget(); widget != nil {
+            releaseWidgetTree(widget)
+        }
+    }
+    pm.customChildren = nil
+}
+
 // SetParent sets the parent widget for the popover
 func (pm *PopoverMenu) SetParent(parent Widget) {
     C.set_popover_parent(
@@ -195,11 +470,50 @@ func (pm *PopoverMenu) ConnectDeactivate(callback func()) {
     Connect(pm, SignalDeactivate, callback)
 }
 
+// ConnectClosed connects a callback for when the popover is hidden,
+// including when it is dismissed by clicking outside of it
+func (pm *PopoverMenu) ConnectClosed(callback func()) uint64 {
+    return Connect(pm, SignalClosed, callback)
+}
+
+// ShowContextMenu pops up a menu pointing at the given widget coordinates,
+// typically the position of a right-click from a GestureClick.
+// The popover is parented to the widget and dismissed by clicking outside
+// of it or activating an item. Once closed, its callbacks are disconnected
+// and it is destroyed, so the returned popover must not be used after that.
+func (w *BaseWidget) ShowContextMenu(menu *Menu, x, y float64) *PopoverMenu {
+    popover := NewPopoverMenu(menu)
+    popover.SetParent(w)
+
+    cPopover := (*C.GtkPopover)(unsafe.Pointer(popover.widget))
+    C.set_popover_pointing_to_point(cPopover, C.int(x), C.int(y))
+    C.gtk_popover_set_position(cPopover, C.GTK_POS_BOTTOM)
+    C.gtk_popover_set_has_arrow(cPopover, C.FALSE)
+    C.gtk_popover_set_autohide(cPopover, C.TRUE)
+
+    popover.ConnectClosed(func() {
+        if popover.widget == nil {
+            return
+        }
+
+        // Clean up callbacks and release the popover from its parent
+        popover.releaseCustomChildren()
+        DisconnectAll(popover)
+        widget := popover.widget
+        popover.widget = nil
+        C.unparent_widget_later(widget)
+    })
+
+    popover.Popup()
+    return popover
+}
+
 // Destroy overrides BaseWidget's Destroy to clean up resources
 func (pm *PopoverMenu) Destroy() {
     // Clean up all callbacks using the unified system
+    pm.releaseCustomChildren()
     DisconnectAll(pm)
-    
+
     // Call the base method
     pm.BaseWidget.Destroy()
 }
@@ -0,0 +1,27 @@
+package gtk4
+
+import "testing"
+
+func TestNewMenuItemWithTarget(t *testing.T) {
+	onUIThread(t, func() {
+		menu := NewMenu()
+
+		item, err := NewRadioMenuItem("Dark", "app.theme", "dark")
+		if err != nil {
+			t.Errorf("NewRadioMenuItem with a string target: %v", err)
+			return
+		}
+		menu.AppendItem(item)
+
+		if item, err := NewMenuItemWithTarget("Item", "app.item", struct{}{}); err == nil || item != nil {
+			t.Errorf("NewMenuItemWithTarget with an unsupported target = %v, %v, want an error", item, err)
+		}
+		if item, err := NewRadioMenuItem("Item", "app.item", 1<<40); err == nil || item != nil {
+			t.Errorf("NewRadioMenuItem with an int out of range = %v, %v, want an error", item, err)
+		}
+
+		if n := menu.GetNItems(); n != 1 {
+			t.Errorf("menu has %d items, want 1", n)
+		}
+	})
+}
@@ -0,0 +1,112 @@
+// Package gtk4 provides overlay container functionality for GTK4
+// File: gtk4go/gtk4/overlay.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// OverlayOption is a function that configures an overlay
+type OverlayOption func(*Overlay)
+
+// Overlay represents a GTK overlay container that stacks floating widgets
+// on top of a main child. Overlay children are positioned using their
+// horizontal and vertical alignment (see SetHAlign and SetVAlign).
+type Overlay struct {
+	BaseWidget
+}
+
+// NewOverlay creates a new GTK overlay container
+func NewOverlay(options ...OverlayOption) *Overlay {
+	overlay := &Overlay{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_overlay_new(),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(overlay)
+	}
+
+	SetupFinalization(overlay, overlay.Destroy)
+	return overlay
+}
+
+// WithOverlayChild sets the main child of the overlay
+func WithOverlayChild(child Widget) OverlayOption {
+	return func(o *Overlay) {
+		o.SetChild(child)
+	}
+}
+
+// SetChild sets the main child of the overlay, which determines its size
+func (o *Overlay) SetChild(child Widget) {
+	if child == nil {
+		C.gtk_overlay_set_child((*C.GtkOverlay)(unsafe.Pointer(o.widget)), nil)
+		return
+	}
+	C.gtk_overlay_set_child((*C.GtkOverlay)(unsafe.Pointer(o.widget)), child.GetWidget())
+}
+
+// AddOverlay adds a floating widget on top of the main child.
+// Input only reaches the main child where the overlay widget doesn't cover it;
+// call SetCanTarget(false) on the overlay widget to let input pass through entirely.
+func (o *Overlay) AddOverlay(widget Widget) {
+	C.gtk_overlay_add_overlay((*C.GtkOverlay)(unsafe.Pointer(o.widget)), widget.GetWidget())
+}
+
+// RemoveOverlay removes a floating widget that was added with AddOverlay
+func (o *Overlay) RemoveOverlay(widget Widget) {
+	if C.gtk_widget_get_parent(widget.GetWidget()) != o.widget {
+		return
+	}
+	C.gtk_overlay_remove_overlay((*C.GtkOverlay)(unsafe.Pointer(o.widget)), widget.GetWidget())
+}
+
+// SetMeasureOverlay sets whether the overlay widget is included in the
+// overlay's size request
+func (o *Overlay) SetMeasureOverlay(widget Widget, measure bool) {
+	var cMeasure C.gboolean
+	if measure {
+		cMeasure = C.TRUE
+	} else {
+		cMeasure = C.FALSE
+	}
+	C.gtk_overlay_set_measure_overlay((*C.GtkOverlay)(unsafe.Pointer(o.widget)), widget.GetWidget(), cMeasure)
+}
+
+// GetMeasureOverlay gets whether the overlay widget is included in the size request
+func (o *Overlay) GetMeasureOverlay(widget Widget) bool {
+	return C.gtk_overlay_get_measure_overlay((*C.GtkOverlay)(unsafe.Pointer(o.widget)), widget.GetWidget()) == C.TRUE
+}
+
+// SetClipOverlay sets whether the overlay widget is clipped to the overlay's bounds
+func (o *Overlay) SetClipOverlay(widget Widget, clip bool) {
+	var cClip C.gboolean
+	if clip {
+		cClip = C.TRUE
+	} else {
+		cClip = C.FALSE
+	}
+	C.gtk_overlay_set_clip_overlay((*C.GtkOverlay)(unsafe.Pointer(o.widget)), widget.GetWidget(), cClip)
+}
+
+// GetClipOverlay gets whether the overlay widget is clipped to the overlay's bounds
+func (o *Overlay) GetClipOverlay(widget Widget) bool {
+	return C.gtk_overlay_get_clip_overlay((*C.GtkOverlay)(unsafe.Pointer(o.widget)), widget.GetWidget()) == C.TRUE
+}
+
+// Destroy destroys the overlay and cleans up resources
+func (o *Overlay) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(o)
+
+	// Call base destroy method
+	o.BaseWidget.Destroy()
+}
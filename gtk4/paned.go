@@ -5,12 +5,50 @@ package gtk4
 // #cgo pkg-config: gtk4
 // #include <gtk/gtk.h>
 // #include <stdlib.h>
+//
+// extern void panedPositionCallback(GObject *object, GParamSpec *pspec, gpointer user_data);
+//
+// #define PENDING_POSITION_KEY "gtk4go-pending-position"
+//
+// static void panedApplyPendingPosition(GtkWidget *widget, gpointer user_data) {
+//     g_signal_handlers_disconnect_by_func(widget, G_CALLBACK(panedApplyPendingPosition), NULL);
+//
+//     int *pending = g_object_get_data(G_OBJECT(widget), PENDING_POSITION_KEY);
+//     if (pending != NULL) {
+//         gtk_paned_set_position(GTK_PANED(widget), *pending);
+//         g_object_set_data(G_OBJECT(widget), PENDING_POSITION_KEY, NULL);
+//     }
+// }
+//
+// // Set the position, and set it again when the paned is mapped so that it
+// // isn't lost to clamping against a size the paned had before it was shown
+// static void panedSetPosition(GtkPaned *paned, int position) {
+//     GtkWidget *widget = GTK_WIDGET(paned);
+//     gtk_paned_set_position(paned, position);
+//     if (gtk_widget_get_mapped(widget)) {
+//         return;
+//     }
+//
+//     if (g_object_get_data(G_OBJECT(widget), PENDING_POSITION_KEY) == NULL) {
+//         g_signal_connect(widget, "map", G_CALLBACK(panedApplyPendingPosition), NULL);
+//     }
+//     int *pending = g_new(int, 1);
+//     *pending = position;
+//     g_object_set_data_full(G_OBJECT(widget), PENDING_POSITION_KEY, pending, g_free);
+// }
+//
+// static gulong connectPanedPosition(GtkPaned *paned, guint callbackId) {
+//     return g_signal_connect(paned, "notify::position", G_CALLBACK(panedPositionCallback), GUINT_TO_POINTER(callbackId));
+// }
 import "C"
 
 import (
 	"unsafe"
 )
 
+// SignalNotifyPosition is emitted when the position of a paned's divider changes
+const SignalNotifyPosition SignalType = "notify::position"
+
 // PanedOption is a function that configures a paned container
 type PanedOption func(*Paned)
 
@@ -39,7 +77,7 @@ func NewPaned(orientation Orientation, options ...PanedOption) *Paned {
 // WithPosition sets the initial position of the divider
 func WithPosition(position int) PanedOption {
 	return func(p *Paned) {
-		C.gtk_paned_set_position((*C.GtkPaned)(unsafe.Pointer(p.widget)), C.int(position))
+		p.SetPosition(position)
 	}
 }
 
@@ -86,9 +124,19 @@ func (p *Paned) GetEndChild() Widget {
 	return nil
 }
 
-// SetPosition sets the position of the divider
+// SetPosition sets the position of the divider in pixels from the start.
+// Before the paned is shown, the position is applied again once it is
+// mapped, so a restored position isn't clamped to a not-yet-allocated size.
 func (p *Paned) SetPosition(position int) {
-	C.gtk_paned_set_position((*C.GtkPaned)(unsafe.Pointer(p.widget)), C.int(position))
+	C.panedSetPosition((*C.GtkPaned)(unsafe.Pointer(p.widget)), C.int(position))
+}
+
+// ConnectNotifyPosition connects a callback for changes of the divider
+// position, whether made by the user or by SetPosition
+func (p *Paned) ConnectNotifyPosition(callback func(position int)) uint64 {
+	return connectCustomSignal(p, SignalNotifyPosition, callback, func(id C.guint) C.gulong {
+		return C.connectPanedPosition((*C.GtkPaned)(unsafe.Pointer(p.widget)), id)
+	})
 }
 
 // GetPosition gets the position of the divider
@@ -112,38 +160,66 @@ func (p *Paned) GetWideHandle() bool {
 	return C.gtk_paned_get_wide_handle((*C.GtkPaned)(unsafe.Pointer(p.widget))) == C.TRUE
 }
 
-// SetStartChildResizable sets whether the start child is resizable
-func (p *Paned) SetStartChildResizable(resizable bool) {
-	var cresizable C.gboolean
-	if resizable {
-		cresizable = C.TRUE
+// SetResizeStartChild sets whether the start child grows and shrinks when the paned is resized
+func (p *Paned) SetResizeStartChild(resize bool) {
+	var cresize C.gboolean
+	if resize {
+		cresize = C.TRUE
 	} else {
-		cresizable = C.FALSE
+		cresize = C.FALSE
 	}
-	C.gtk_paned_set_resize_start_child((*C.GtkPaned)(unsafe.Pointer(p.widget)), cresizable)
+	C.gtk_paned_set_resize_start_child((*C.GtkPaned)(unsafe.Pointer(p.widget)), cresize)
 }
 
-// GetStartChildResizable gets whether the start child is resizable
-func (p *Paned) GetStartChildResizable() bool {
+// GetResizeStartChild gets whether the start child grows and shrinks when the paned is resized
+func (p *Paned) GetResizeStartChild() bool {
 	return C.gtk_paned_get_resize_start_child((*C.GtkPaned)(unsafe.Pointer(p.widget))) == C.TRUE
 }
 
-// SetEndChildResizable sets whether the end child is resizable
-func (p *Paned) SetEndChildResizable(resizable bool) {
-	var cresizable C.gboolean
-	if resizable {
-		cresizable = C.TRUE
+// SetResizeEndChild sets whether the end child grows and shrinks when the paned is resized
+func (p *Paned) SetResizeEndChild(resize bool) {
+	var cresize C.gboolean
+	if resize {
+		cresize = C.TRUE
 	} else {
-		cresizable = C.FALSE
+		cresize = C.FALSE
 	}
-	C.gtk_paned_set_resize_end_child((*C.GtkPaned)(unsafe.Pointer(p.widget)), cresizable)
+	C.gtk_paned_set_resize_end_child((*C.GtkPaned)(unsafe.Pointer(p.widget)), cresize)
 }
 
-// GetEndChildResizable gets whether the end child is resizable
-func (p *Paned) GetEndChildResizable() bool {
+// GetResizeEndChild gets whether the end child grows and shrinks when the paned is resized
+func (p *Paned) GetResizeEndChild() bool {
 	return C.gtk_paned_get_resize_end_child((*C.GtkPaned)(unsafe.Pointer(p.widget))) == C.TRUE
 }
 
+// SetStartChildResizable sets whether the start child is resizable.
+//
+// Deprecated: Use SetResizeStartChild.
+func (p *Paned) SetStartChildResizable(resizable bool) {
+	p.SetResizeStartChild(resizable)
+}
+
+// GetStartChildResizable gets whether the start child is resizable.
+//
+// Deprecated: Use GetResizeStartChild.
+func (p *Paned) GetStartChildResizable() bool {
+	return p.GetResizeStartChild()
+}
+
+// SetEndChildResizable sets whether the end child is resizable.
+//
+// Deprecated: Use SetResizeEndChild.
+func (p *Paned) SetEndChildResizable(resizable bool) {
+	p.SetResizeEndChild(resizable)
+}
+
+// GetEndChildResizable gets whether the end child is resizable.
+//
+// Deprecated: Use GetResizeEndChild.
+func (p *Paned) GetEndChildResizable() bool {
+	return p.GetResizeEndChild()
+}
+
 // SetShrinkStartChild sets whether the start child can be made smaller than its requisition
 func (p *Paned) SetShrinkStartChild(shrink bool) {
 	var cshrink C.gboolean
@@ -175,3 +251,28 @@ func (p *Paned) SetShrinkEndChild(shrink bool) {
 func (p *Paned) GetShrinkEndChild() bool {
 	return C.gtk_paned_get_shrink_end_child((*C.GtkPaned)(unsafe.Pointer(p.widget))) == C.TRUE
 }
+
+// Destroy destroys the paned container and cleans up resources
+func (p *Paned) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(p)
+
+	// Call base destroy method
+	p.BaseWidget.Destroy()
+}
+
+//export panedPositionCallback
+func panedPositionCallback(object *C.GObject, pspec *C.GParamSpec, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	if cb, ok := callback.(func(int)); ok {
+		position := int(C.gtk_paned_get_position((*C.GtkPaned)(unsafe.Pointer(object))))
+		SafeCallback(cb, position)
+	} else {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"panedPositionCallback: callback has wrong type: %T", callback)
+	}
+}
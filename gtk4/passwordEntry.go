@@ -0,0 +1,111 @@
+// Package gtk4 provides password entry functionality for GTK4
+// File: gtk4go/gtk4/passwordEntry.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// PasswordEntryOption is a function that configures a password entry
+type PasswordEntryOption func(*PasswordEntry)
+
+// PasswordEntry represents a GTK password entry, a text entry that hides its
+// text, warns when Caps Lock is on and can show an icon to reveal the text.
+// Its text is never passed to debug logging.
+type PasswordEntry struct {
+	BaseWidget
+}
+
+// NewPasswordEntry creates a new GTK password entry
+func NewPasswordEntry(options ...PasswordEntryOption) *PasswordEntry {
+	entry := &PasswordEntry{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_password_entry_new(),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(entry)
+	}
+
+	SetupFinalization(entry, entry.Destroy)
+	return entry
+}
+
+// WithShowPeekIcon sets whether an icon to reveal the text is shown
+func WithShowPeekIcon(show bool) PasswordEntryOption {
+	return func(e *PasswordEntry) {
+		e.SetShowPeekIcon(show)
+	}
+}
+
+// WithPasswordPlaceholderText sets the text shown while the password entry is empty
+func WithPasswordPlaceholderText(text string) PasswordEntryOption {
+	return func(e *PasswordEntry) {
+		e.SetPlaceholderText(text)
+	}
+}
+
+// SetText sets the text of the password entry
+func (e *PasswordEntry) SetText(text string) {
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+	C.gtk_editable_set_text((*C.GtkEditable)(unsafe.Pointer(e.widget)), cText)
+}
+
+// GetText gets the text of the password entry
+func (e *PasswordEntry) GetText() string {
+	cText := C.gtk_editable_get_text((*C.GtkEditable)(unsafe.Pointer(e.widget)))
+	if cText == nil {
+		return ""
+	}
+	return C.GoString(cText)
+}
+
+// SetPlaceholderText sets the text shown while the password entry is empty
+func (e *PasswordEntry) SetPlaceholderText(text string) {
+	// GtkPasswordEntry only exposes the placeholder as a property
+	e.SetProperty("placeholder-text", text)
+}
+
+// SetShowPeekIcon sets whether an icon to reveal the text is shown
+func (e *PasswordEntry) SetShowPeekIcon(show bool) {
+	var cshow C.gboolean
+	if show {
+		cshow = C.TRUE
+	} else {
+		cshow = C.FALSE
+	}
+	C.gtk_password_entry_set_show_peek_icon((*C.GtkPasswordEntry)(unsafe.Pointer(e.widget)), cshow)
+}
+
+// GetShowPeekIcon returns whether an icon to reveal the text is shown
+func (e *PasswordEntry) GetShowPeekIcon() bool {
+	return C.gtk_password_entry_get_show_peek_icon((*C.GtkPasswordEntry)(unsafe.Pointer(e.widget))) == C.TRUE
+}
+
+// ConnectActivate connects a callback for when the user presses Enter
+func (e *PasswordEntry) ConnectActivate(callback func()) uint64 {
+	return Connect(e, SignalActivate, callback)
+}
+
+// ConnectChanged connects a callback for changes of the text. Use GetText in
+// the callback to read the text.
+func (e *PasswordEntry) ConnectChanged(callback func()) uint64 {
+	return Connect(e, SignalChanged, callback)
+}
+
+// Destroy destroys the password entry and cleans up resources
+func (e *PasswordEntry) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(e)
+
+	// Call base destroy method
+	e.BaseWidget.Destroy()
+}
@@ -0,0 +1,186 @@
+// Package gtk4 provides popover functionality for GTK4
+// File: gtk4go/gtk4/popover.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// static void popoverPopupOnMap(GtkWidget *parent, gpointer user_data) {
+//     GtkPopover *popover = GTK_POPOVER(user_data);
+//     g_signal_handlers_disconnect_by_func(parent, G_CALLBACK(popoverPopupOnMap), user_data);
+//     gtk_popover_popup(popover);
+// }
+//
+// // Pop up the popover, or wait until its parent is mapped if it isn't yet,
+// // since a popover can only be shown once its parent is on screen
+// static void popoverPopup(GtkPopover *popover) {
+//     GtkWidget *parent = gtk_widget_get_parent(GTK_WIDGET(popover));
+//     if (parent == NULL || gtk_widget_get_mapped(parent)) {
+//         gtk_popover_popup(popover);
+//         return;
+//     }
+//
+//     // Replace an earlier deferred popup rather than adding another
+//     g_signal_handlers_disconnect_by_func(parent, G_CALLBACK(popoverPopupOnMap), popover);
+//     g_signal_connect_object(parent, "map", G_CALLBACK(popoverPopupOnMap), popover, 0);
+// }
+//
+// // Hide the popover and cancel a deferred popup
+// static void popoverPopdown(GtkPopover *popover) {
+//     GtkWidget *parent = gtk_widget_get_parent(GTK_WIDGET(popover));
+//     if (parent != NULL) {
+//         g_signal_handlers_disconnect_by_func(parent, G_CALLBACK(popoverPopupOnMap), popover);
+//     }
+//     gtk_popover_popdown(popover);
+// }
+//
+// static void popoverSetPointingTo(GtkPopover *popover, int x, int y, int width, int height) {
+//     GdkRectangle rect = { x, y, width, height };
+//     gtk_popover_set_pointing_to(popover, &rect);
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+// PopoverOption is a function that configures a popover
+type PopoverOption func(*Popover)
+
+// Popover represents a GTK popover, a bubble attached to a parent widget
+// that holds arbitrary content, such as a small form
+type Popover struct {
+	BaseWidget
+}
+
+// NewPopover creates a new GTK popover
+func NewPopover(options ...PopoverOption) *Popover {
+	popover := &Popover{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_popover_new(),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(popover)
+	}
+
+	SetupFinalization(popover, popover.Destroy)
+	return popover
+}
+
+// WithPopoverChild sets the content of the popover
+func WithPopoverChild(child Widget) PopoverOption {
+	return func(p *Popover) {
+		p.SetChild(child)
+	}
+}
+
+// WithAutohide sets whether the popover is hidden when clicking outside of it
+func WithAutohide(autohide bool) PopoverOption {
+	return func(p *Popover) {
+		p.SetAutohide(autohide)
+	}
+}
+
+// WithPopoverPosition sets the preferred side of the parent to show the popover on
+func WithPopoverPosition(position GridPosition) PopoverOption {
+	return func(p *Popover) {
+		p.SetPosition(position)
+	}
+}
+
+// popover returns the widget as a GtkPopover
+func (p *Popover) popover() *C.GtkPopover {
+	return (*C.GtkPopover)(unsafe.Pointer(p.widget))
+}
+
+// SetChild sets the content of the popover; nil removes it
+func (p *Popover) SetChild(child Widget) {
+	if child == nil {
+		C.gtk_popover_set_child(p.popover(), nil)
+		return
+	}
+	C.gtk_popover_set_child(p.popover(), child.GetWidget())
+}
+
+// SetParent attaches the popover to the widget it points at. Destroy
+// detaches it again.
+func (p *Popover) SetParent(parent Widget) {
+	C.gtk_widget_set_parent(p.widget, parent.GetWidget())
+}
+
+// SetPointingTo sets the area of the parent, in the parent's coordinates,
+// that the popover points at. By default it points at the whole parent.
+func (p *Popover) SetPointingTo(x, y, width, height int) {
+	C.popoverSetPointingTo(p.popover(), C.int(x), C.int(y), C.int(width), C.int(height))
+}
+
+// SetPosition sets the preferred side of the parent to show the popover on.
+// GTK uses another side if there isn't enough space.
+func (p *Popover) SetPosition(position GridPosition) {
+	C.gtk_popover_set_position(p.popover(), C.GtkPositionType(position))
+}
+
+// SetAutohide sets whether the popover is hidden when clicking outside of
+// it or pressing Escape. Without autohide, the popover stays open until
+// Popdown is called and doesn't grab the keyboard.
+func (p *Popover) SetAutohide(autohide bool) {
+	var cautohide C.gboolean
+	if autohide {
+		cautohide = C.TRUE
+	} else {
+		cautohide = C.FALSE
+	}
+	C.gtk_popover_set_autohide(p.popover(), cautohide)
+}
+
+// GetAutohide returns whether the popover is hidden when clicking outside of it
+func (p *Popover) GetAutohide() bool {
+	return C.gtk_popover_get_autohide(p.popover()) == C.TRUE
+}
+
+// SetHasArrow sets whether the popover has an arrow pointing at its parent
+func (p *Popover) SetHasArrow(hasArrow bool) {
+	var chasArrow C.gboolean
+	if hasArrow {
+		chasArrow = C.TRUE
+	} else {
+		chasArrow = C.FALSE
+	}
+	C.gtk_popover_set_has_arrow(p.popover(), chasArrow)
+}
+
+// SetDefaultWidget sets the widget activated when Enter is pressed in the popover
+func (p *Popover) SetDefaultWidget(widget Widget) {
+	C.gtk_popover_set_default_widget(p.popover(), widget.GetWidget())
+}
+
+// Popup shows the popover. If the parent isn't shown yet, the popover is
+// shown once it is.
+func (p *Popover) Popup() {
+	C.popoverPopup(p.popover())
+}
+
+// Popdown hides the popover, and cancels a popup waiting for the parent to
+// be shown
+func (p *Popover) Popdown() {
+	C.popoverPopdown(p.popover())
+}
+
+// ConnectClosed connects a callback for when the popover is hidden,
+// including when it is dismissed by clicking outside of it
+func (p *Popover) ConnectClosed(callback func()) uint64 {
+	return Connect(p, SignalClosed, callback)
+}
+
+// Destroy destroys the popover and cleans up resources
+func (p *Popover) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(p)
+
+	// Call base destroy method, which detaches the popover from its parent
+	p.BaseWidget.Destroy()
+}
@@ -0,0 +1,117 @@
+// Package gtk4 provides progress bar functionality for GTK4
+// File: gtk4go/gtk4/progressBar.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// ProgressBarOption is a function that configures a progress bar
+type ProgressBarOption func(*ProgressBar)
+
+// ProgressBar represents a GTK progress bar
+type ProgressBar struct {
+	BaseWidget
+}
+
+// NewProgressBar creates a new GTK progress bar
+func NewProgressBar(options ...ProgressBarOption) *ProgressBar {
+	progressBar := &ProgressBar{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_progress_bar_new(),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(progressBar)
+	}
+
+	SetupFinalization(progressBar, progressBar.Destroy)
+	return progressBar
+}
+
+// WithShowText sets whether the progress bar shows its text
+func WithShowText(showText bool) ProgressBarOption {
+	return func(pb *ProgressBar) {
+		pb.SetShowText(showText)
+	}
+}
+
+// SetFraction sets the fraction of the bar that is filled, between 0.0 and 1.0
+func (pb *ProgressBar) SetFraction(fraction float64) {
+	if fraction < 0 {
+		fraction = 0
+	} else if fraction > 1 {
+		fraction = 1
+	}
+	C.gtk_progress_bar_set_fraction((*C.GtkProgressBar)(unsafe.Pointer(pb.widget)), C.double(fraction))
+}
+
+// GetFraction gets the fraction of the bar that is filled
+func (pb *ProgressBar) GetFraction() float64 {
+	return float64(C.gtk_progress_bar_get_fraction((*C.GtkProgressBar)(unsafe.Pointer(pb.widget))))
+}
+
+// Pulse moves the activity indicator to show that some progress has been made
+// when the total amount of work is unknown
+func (pb *ProgressBar) Pulse() {
+	C.gtk_progress_bar_pulse((*C.GtkProgressBar)(unsafe.Pointer(pb.widget)))
+}
+
+// SetPulseStep sets the fraction of the bar to move on each Pulse
+func (pb *ProgressBar) SetPulseStep(fraction float64) {
+	C.gtk_progress_bar_set_pulse_step((*C.GtkProgressBar)(unsafe.Pointer(pb.widget)), C.double(fraction))
+}
+
+// SetText sets the text shown on the progress bar
+func (pb *ProgressBar) SetText(text string) {
+	WithCString(text, func(cText *C.char) {
+		C.gtk_progress_bar_set_text((*C.GtkProgressBar)(unsafe.Pointer(pb.widget)), cText)
+	})
+}
+
+// GetText gets the text shown on the progress bar
+func (pb *ProgressBar) GetText() string {
+	cText := C.gtk_progress_bar_get_text((*C.GtkProgressBar)(unsafe.Pointer(pb.widget)))
+	if cText == nil {
+		return ""
+	}
+	return C.GoString(cText)
+}
+
+// SetShowText sets whether the progress bar shows its text
+func (pb *ProgressBar) SetShowText(showText bool) {
+	var cShowText C.gboolean
+	if showText {
+		cShowText = C.TRUE
+	} else {
+		cShowText = C.FALSE
+	}
+	C.gtk_progress_bar_set_show_text((*C.GtkProgressBar)(unsafe.Pointer(pb.widget)), cShowText)
+}
+
+// SetInverted sets whether the progress bar fills in the opposite direction
+func (pb *ProgressBar) SetInverted(inverted bool) {
+	var cInverted C.gboolean
+	if inverted {
+		cInverted = C.TRUE
+	} else {
+		cInverted = C.FALSE
+	}
+	C.gtk_progress_bar_set_inverted((*C.GtkProgressBar)(unsafe.Pointer(pb.widget)), cInverted)
+}
+
+// Destroy destroys the progress bar and cleans up resources
+func (pb *ProgressBar) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(pb)
+
+	// Call base destroy method
+	pb.BaseWidget.Destroy()
+}
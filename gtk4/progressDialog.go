@@ -0,0 +1,144 @@
+// Package gtk4 provides progress dialog functionality for GTK4
+// File: gtk4go/gtk4/progressDialog.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"unsafe"
+
+	"github.com/justyntemme/gtk4go"
+)
+
+// ProgressDialog is a dialog that shows the progress of a background task
+// with a progress bar, a message label and a Cancel button
+type ProgressDialog struct {
+	Dialog
+	progressBar  *ProgressBar
+	messageLabel *Label
+	cancelButton *Button
+	cancelFunc   context.CancelFunc
+	onComplete   func(result interface{}, err error)
+	finished     bool
+}
+
+// NewProgressDialog creates a new progress dialog without starting a task
+func NewProgressDialog(parent *Window, title string) *ProgressDialog {
+	dialog := NewDialog(title, parent, DialogModal|DialogDestroyWithParent)
+
+	progressDialog := &ProgressDialog{
+		Dialog: *dialog,
+	}
+	progressDialog.AddCssClass("progress-dialog")
+
+	// Message label above the bar
+	progressDialog.messageLabel = NewLabel("")
+	progressDialog.messageLabel.AddCssClass("dialog-message")
+	progressDialog.GetContentArea().Append(progressDialog.messageLabel)
+
+	// Progress bar showing the percentage
+	progressDialog.progressBar = NewProgressBar(WithShowText(true))
+	progressDialog.progressBar.SetHExpand(true)
+	progressDialog.GetContentArea().Append(progressDialog.progressBar)
+
+	// Cancel button
+	progressDialog.cancelButton = progressDialog.AddButton("Cancel", ResponseCancel)
+
+	// Closing the window only hides it, so that it is destroyed exactly
+	// once, by complete, after the cancelled task has returned
+	C.gtk_window_set_hide_on_close((*C.GtkWindow)(unsafe.Pointer(progressDialog.widget)), C.TRUE)
+
+	// Cancelling or closing the window cancels the task
+	progressDialog.ConnectResponse(func(response ResponseType) {
+		if response == ResponseCancel || response == ResponseDeleteEvent {
+			progressDialog.Cancel()
+		}
+	})
+
+	progressDialog.SetDefaultSize(360, 120)
+
+	return progressDialog
+}
+
+// ShowProgressDialog shows a progress dialog and runs the task in the background.
+// The bar is updated from the task's progress callback, the Cancel button cancels
+// the task's context, and the dialog is dismissed automatically when the task finishes.
+func ShowProgressDialog(parent *Window, title string, task gtk4go.TaskFunc) *ProgressDialog {
+	progressDialog := NewProgressDialog(parent, title)
+	progressDialog.Present()
+	progressDialog.Run(task)
+	return progressDialog
+}
+
+// Run queues the task on the default background worker and wires its
+// progress and completion to the dialog
+func (pd *ProgressDialog) Run(task gtk4go.TaskFunc) {
+	pd.cancelFunc = gtk4go.QueueBackgroundTask(
+		"",
+		task,
+		pd.complete,
+		pd.SetProgress,
+	)
+}
+
+// ConnectComplete sets a callback that runs on the UI thread when the task
+// finishes, after the dialog has been dismissed. A cancelled task reports
+// context.Canceled as its error.
+func (pd *ProgressDialog) ConnectComplete(callback func(result interface{}, err error)) {
+	pd.onComplete = callback
+}
+
+// SetProgress updates the progress bar and message. Must be called on the UI thread.
+func (pd *ProgressDialog) SetProgress(percent int, message string) {
+	if pd.finished {
+		return
+	}
+
+	pd.progressBar.SetFraction(float64(percent) / 100.0)
+	pd.progressBar.SetText(fmt.Sprintf("%d%%", percent))
+	if message != "" {
+		pd.messageLabel.SetText(message)
+	}
+}
+
+// SetMessage sets the message shown above the progress bar
+func (pd *ProgressDialog) SetMessage(message string) {
+	pd.messageLabel.SetText(message)
+}
+
+// GetProgressBar returns the dialog's progress bar
+func (pd *ProgressDialog) GetProgressBar() *ProgressBar {
+	return pd.progressBar
+}
+
+// Cancel cancels the running task. The dialog stays open until the task
+// has observed the cancellation and returned, unless it was closed from the
+// title bar, which hides it right away.
+func (pd *ProgressDialog) Cancel() {
+	if pd.finished || pd.cancelFunc == nil {
+		return
+	}
+
+	pd.cancelFunc()
+	pd.messageLabel.SetText("Cancelling...")
+	C.gtk_widget_set_sensitive(pd.cancelButton.widget, C.FALSE)
+}
+
+// complete dismisses the dialog and reports the result
+func (pd *ProgressDialog) complete(result interface{}, err error) {
+	if pd.finished {
+		return
+	}
+	pd.finished = true
+
+	pd.Destroy()
+
+	if pd.onComplete != nil {
+		pd.onComplete(result, err)
+	}
+}
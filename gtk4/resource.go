@@ -0,0 +1,107 @@
+// Package gtk4 provides GResource functionality for GTK4
+// File: gtk4go/gtk4/resource.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// // Create a resource from a copy of the given compiled resource bundle
+// static GResource* resourceNewFromData(const void *data, gsize size, GError **error) {
+//     GBytes *bytes = g_bytes_new(data, size);
+//     GResource *resource = g_resource_new_from_data(bytes, error);
+//     g_bytes_unref(bytes);
+//     return resource;
+// }
+import "C"
+
+import (
+	"errors"
+	"runtime"
+	"unsafe"
+)
+
+// Resource represents a registered GResource bundle, as compiled by
+// glib-compile-resources. Files in a registered bundle can be loaded by
+// their resource path, e.g. with LoadCSSFromResource, so that they can be
+// embedded in the binary (for example with go:embed) instead of shipped
+// alongside it.
+type Resource struct {
+	resource *C.GResource
+}
+
+// RegisterResource registers a compiled resource bundle and makes its files
+// available by their resource paths. The data is copied. An error is
+// returned if the data isn't a valid resource bundle.
+func RegisterResource(data []byte) (*Resource, error) {
+	if len(data) == 0 {
+		return nil, &GTKError{Op: "RegisterResource", Err: errors.New("empty resource data")}
+	}
+
+	var gerr *C.GError
+	cResource := C.resourceNewFromData(unsafe.Pointer(&data[0]), C.gsize(len(data)), &gerr)
+	if cResource == nil {
+		return nil, &GTKError{Op: "RegisterResource", Err: takeGError(gerr)}
+	}
+
+	C.g_resources_register(cResource)
+
+	resource := &Resource{resource: cResource}
+	runtime.SetFinalizer(resource, (*Resource).Unregister)
+	return resource, nil
+}
+
+// Unregister removes the resource bundle, e.g. at shutdown. Files that were
+// already loaded from it are unaffected. Calling it again has no effect.
+func (r *Resource) Unregister() {
+	if r.resource != nil {
+		C.g_resources_unregister(r.resource)
+		C.g_resource_unref(r.resource)
+		r.resource = nil
+	}
+}
+
+// Native returns the underlying GResource pointer as uintptr
+func (r *Resource) Native() uintptr {
+	return uintptr(unsafe.Pointer(r.resource))
+}
+
+// LookupResourceData returns the contents of a file in the registered
+// resource bundles, e.g. "/com/example/app/style.css"
+func LookupResourceData(path string) ([]byte, error) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	var gerr *C.GError
+	bytes := C.g_resources_lookup_data(cPath, C.G_RESOURCE_LOOKUP_FLAGS_NONE, &gerr)
+	if bytes == nil {
+		return nil, &GTKError{Op: "LookupResourceData", Err: takeGError(gerr)}
+	}
+	defer C.g_bytes_unref(bytes)
+
+	var size C.gsize
+	data := C.g_bytes_get_data(bytes, &size)
+	return C.GoBytes(data, C.int(size)), nil
+}
+
+// LoadCSSFromResource is a convenience function to create a provider and
+// load CSS from a file in the registered resource bundles. Parsing errors
+// are reported like LoadCSS.
+func LoadCSSFromResource(path string) (*CSSProvider, error) {
+	data, err := LookupResourceData(path)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := newCSSProvider()
+	return provider, provider.loadFromData(string(data))
+}
+
+// takeGError converts a GError to a Go error and frees it
+func takeGError(gerr *C.GError) error {
+	if gerr == nil {
+		return errors.New("unknown error")
+	}
+	defer C.g_error_free(gerr)
+	return errors.New(C.GoString((*C.char)(unsafe.Pointer(gerr.message))))
+}
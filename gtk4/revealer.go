@@ -0,0 +1,151 @@
+// Package gtk4 provides revealer functionality for GTK4
+// File: gtk4go/gtk4/revealer.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// RevealerTransitionType defines the animation used when revealing or hiding the child
+type RevealerTransitionType int
+
+const (
+	// RevealerTransitionTypeNone no transition
+	RevealerTransitionTypeNone RevealerTransitionType = C.GTK_REVEALER_TRANSITION_TYPE_NONE
+	// RevealerTransitionTypeCrossfade fade in
+	RevealerTransitionTypeCrossfade RevealerTransitionType = C.GTK_REVEALER_TRANSITION_TYPE_CROSSFADE
+	// RevealerTransitionTypeSlideRight slide in from the left
+	RevealerTransitionTypeSlideRight RevealerTransitionType = C.GTK_REVEALER_TRANSITION_TYPE_SLIDE_RIGHT
+	// RevealerTransitionTypeSlideLeft slide in from the right
+	RevealerTransitionTypeSlideLeft RevealerTransitionType = C.GTK_REVEALER_TRANSITION_TYPE_SLIDE_LEFT
+	// RevealerTransitionTypeSlideUp slide in from the bottom
+	RevealerTransitionTypeSlideUp RevealerTransitionType = C.GTK_REVEALER_TRANSITION_TYPE_SLIDE_UP
+	// RevealerTransitionTypeSlideDown slide in from the top
+	RevealerTransitionTypeSlideDown RevealerTransitionType = C.GTK_REVEALER_TRANSITION_TYPE_SLIDE_DOWN
+	// RevealerTransitionTypeSwingRight floop in from the left
+	RevealerTransitionTypeSwingRight RevealerTransitionType = C.GTK_REVEALER_TRANSITION_TYPE_SWING_RIGHT
+	// RevealerTransitionTypeSwingLeft floop in from the right
+	RevealerTransitionTypeSwingLeft RevealerTransitionType = C.GTK_REVEALER_TRANSITION_TYPE_SWING_LEFT
+	// RevealerTransitionTypeSwingUp floop in from the bottom
+	RevealerTransitionTypeSwingUp RevealerTransitionType = C.GTK_REVEALER_TRANSITION_TYPE_SWING_UP
+	// RevealerTransitionTypeSwingDown floop in from the top
+	RevealerTransitionTypeSwingDown RevealerTransitionType = C.GTK_REVEALER_TRANSITION_TYPE_SWING_DOWN
+)
+
+// RevealerOption is a function that configures a revealer
+type RevealerOption func(*Revealer)
+
+// Revealer represents a GTK revealer that animates showing and hiding its child
+type Revealer struct {
+	BaseWidget
+}
+
+// NewRevealer creates a new GTK revealer
+func NewRevealer(options ...RevealerOption) *Revealer {
+	revealer := &Revealer{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_revealer_new(),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(revealer)
+	}
+
+	SetupFinalization(revealer, revealer.Destroy)
+	return revealer
+}
+
+// WithRevealChild sets whether the child is initially revealed
+func WithRevealChild(reveal bool) RevealerOption {
+	return func(r *Revealer) {
+		r.SetRevealChild(reveal)
+	}
+}
+
+// WithRevealerTransition sets the transition type and duration in milliseconds
+func WithRevealerTransition(transitionType RevealerTransitionType, duration int) RevealerOption {
+	return func(r *Revealer) {
+		r.SetTransitionType(transitionType)
+		r.SetTransitionDuration(duration)
+	}
+}
+
+// SetChild sets the child widget of the revealer
+func (r *Revealer) SetChild(child Widget) {
+	if child == nil {
+		C.gtk_revealer_set_child((*C.GtkRevealer)(unsafe.Pointer(r.widget)), nil)
+		return
+	}
+	C.gtk_revealer_set_child((*C.GtkRevealer)(unsafe.Pointer(r.widget)), child.GetWidget())
+}
+
+// SetRevealChild sets whether the child should be revealed, starting the transition
+func (r *Revealer) SetRevealChild(reveal bool) {
+	var cReveal C.gboolean
+	if reveal {
+		cReveal = C.TRUE
+	} else {
+		cReveal = C.FALSE
+	}
+	C.gtk_revealer_set_reveal_child((*C.GtkRevealer)(unsafe.Pointer(r.widget)), cReveal)
+}
+
+// GetRevealChild gets whether the child is requested to be revealed.
+// This changes immediately; use GetChildRevealed for the animation state.
+func (r *Revealer) GetRevealChild() bool {
+	return C.gtk_revealer_get_reveal_child((*C.GtkRevealer)(unsafe.Pointer(r.widget))) == C.TRUE
+}
+
+// GetChildRevealed gets whether the child is fully revealed, i.e. whether
+// the transition to the revealed state has completed
+func (r *Revealer) GetChildRevealed() bool {
+	return C.gtk_revealer_get_child_revealed((*C.GtkRevealer)(unsafe.Pointer(r.widget))) == C.TRUE
+}
+
+// SetTransitionType sets the animation used for the transition
+func (r *Revealer) SetTransitionType(transitionType RevealerTransitionType) {
+	C.gtk_revealer_set_transition_type((*C.GtkRevealer)(unsafe.Pointer(r.widget)), C.GtkRevealerTransitionType(transitionType))
+}
+
+// GetTransitionType gets the animation used for the transition
+func (r *Revealer) GetTransitionType() RevealerTransitionType {
+	return RevealerTransitionType(C.gtk_revealer_get_transition_type((*C.GtkRevealer)(unsafe.Pointer(r.widget))))
+}
+
+// SetTransitionDuration sets the duration of the transition in milliseconds
+func (r *Revealer) SetTransitionDuration(duration int) {
+	if duration < 0 {
+		duration = 0
+	}
+	C.gtk_revealer_set_transition_duration((*C.GtkRevealer)(unsafe.Pointer(r.widget)), C.guint(duration))
+}
+
+// GetTransitionDuration gets the duration of the transition in milliseconds
+func (r *Revealer) GetTransitionDuration() int {
+	return int(C.gtk_revealer_get_transition_duration((*C.GtkRevealer)(unsafe.Pointer(r.widget))))
+}
+
+// ConnectNotifyChildRevealed connects a callback that is called with the new
+// value of GetChildRevealed when a transition finishes. When the reveal state
+// is toggled rapidly, only transitions that actually complete are reported.
+func (r *Revealer) ConnectNotifyChildRevealed(callback func(revealed bool)) uint64 {
+	return ConnectNotify(r, "child-revealed", func() {
+		callback(r.GetChildRevealed())
+	})
+}
+
+// Destroy destroys the revealer and cleans up resources
+func (r *Revealer) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(r)
+
+	// Call base destroy method
+	r.BaseWidget.Destroy()
+}
@@ -5,6 +5,46 @@ package gtk4
 // #cgo pkg-config: gtk4
 // #include <gtk/gtk.h>
 // #include <stdlib.h>
+//
+// // Compute the bounds of a widget relative to another widget
+// static gboolean computeWidgetBounds(GtkWidget *widget, GtkWidget *target, double *x, double *y, double *width, double *height) {
+//     graphene_rect_t bounds;
+//     if (!gtk_widget_compute_bounds(widget, target, &bounds)) {
+//         return FALSE;
+//     }
+//     *x = bounds.origin.x;
+//     *y = bounds.origin.y;
+//     *width = bounds.size.width;
+//     *height = bounds.size.height;
+//     return TRUE;
+// }
+//
+// typedef struct {
+//     GtkAdjustment *adjustment;
+//     gboolean to_end;
+// } ScrollRequest;
+//
+// static gboolean scrollAdjustmentIdle(gpointer data) {
+//     ScrollRequest *request = data;
+//     GtkAdjustment *adjustment = request->adjustment;
+//     if (request->to_end) {
+//         gtk_adjustment_set_value(adjustment, gtk_adjustment_get_upper(adjustment) - gtk_adjustment_get_page_size(adjustment));
+//     } else {
+//         gtk_adjustment_set_value(adjustment, gtk_adjustment_get_lower(adjustment));
+//     }
+//     g_object_unref(adjustment);
+//     g_free(request);
+//     return G_SOURCE_REMOVE;
+// }
+//
+// // Scroll an adjustment to its start or end from an idle callback, which
+// // runs after pending layout has updated the adjustment's range
+// static void scrollAdjustmentLater(GtkAdjustment *adjustment, gboolean to_end) {
+//     ScrollRequest *request = g_new(ScrollRequest, 1);
+//     request->adjustment = g_object_ref(adjustment);
+//     request->to_end = to_end;
+//     g_idle_add_full(G_PRIORITY_DEFAULT_IDLE, scrollAdjustmentIdle, request, NULL);
+// }
 import "C"
 
 import (
@@ -199,4 +239,100 @@ func (sw *ScrolledWindow) GetPropagateNaturalHeight() bool {
 	return C.gtk_scrolled_window_get_propagate_natural_height(
 		(*C.GtkScrolledWindow)(unsafe.Pointer(sw.widget)),
 	) == C.TRUE
-}
\ No newline at end of file
+}
+
+// GetHAdjustment returns the adjustment of the horizontal scroll position
+func (sw *ScrolledWindow) GetHAdjustment() *Adjustment {
+	return wrapAdjustment(C.gtk_scrolled_window_get_hadjustment((*C.GtkScrolledWindow)(unsafe.Pointer(sw.widget))))
+}
+
+// GetVAdjustment returns the adjustment of the vertical scroll position
+func (sw *ScrolledWindow) GetVAdjustment() *Adjustment {
+	return wrapAdjustment(C.gtk_scrolled_window_get_vadjustment((*C.GtkScrolledWindow)(unsafe.Pointer(sw.widget))))
+}
+
+// ScrollToTop scrolls to the top once pending layout is done, so content
+// added just before the call is taken into account
+func (sw *ScrolledWindow) ScrollToTop() {
+	sw.scrollVerticallyLater(false)
+}
+
+// ScrollToBottom scrolls to the bottom once pending layout is done, so
+// content appended just before the call is taken into account, e.g. to
+// follow new entries of a log
+func (sw *ScrolledWindow) ScrollToBottom() {
+	sw.scrollVerticallyLater(true)
+}
+
+// scrollVerticallyLater scrolls the vertical adjustment to its start or end
+// from an idle callback
+func (sw *ScrolledWindow) scrollVerticallyLater(toEnd bool) {
+	adjustment := C.gtk_scrolled_window_get_vadjustment((*C.GtkScrolledWindow)(unsafe.Pointer(sw.widget)))
+	if adjustment == nil {
+		return
+	}
+
+	var cToEnd C.gboolean
+	if toEnd {
+		cToEnd = C.TRUE
+	} else {
+		cToEnd = C.FALSE
+	}
+	C.scrollAdjustmentLater(adjustment, cToEnd)
+}
+
+// ScrollToWidget scrolls the window so that the given descendant is visible.
+// If the widget is larger than the visible area, its top-left corner is shown.
+// Widgets that are not descendants of the scrolled window are ignored.
+func (sw *ScrolledWindow) ScrollToWidget(child Widget) {
+	if child == nil || child.GetWidget() == nil {
+		return
+	}
+
+	// Only scroll to widgets inside this scrolled window
+	if C.gtk_widget_is_ancestor(child.GetWidget(), sw.widget) == C.FALSE {
+		DebugLog(DebugLevelWarning, DebugComponentGeneral,
+			"ScrollToWidget: widget %p is not a descendant of the scrolled window", child.GetWidget())
+		return
+	}
+
+	// Bounds of the child relative to the visible area of the scrolled window
+	var x, y, width, height C.double
+	if C.computeWidgetBounds(child.GetWidget(), sw.widget, &x, &y, &width, &height) == C.FALSE {
+		return
+	}
+
+	scrolledWindow := (*C.GtkScrolledWindow)(unsafe.Pointer(sw.widget))
+	scrollAdjustmentToSpan(C.gtk_scrolled_window_get_hadjustment(scrolledWindow), float64(x), float64(width))
+	scrollAdjustmentToSpan(C.gtk_scrolled_window_get_vadjustment(scrolledWindow), float64(y), float64(height))
+}
+
+// scrollAdjustmentToSpan moves an adjustment by the smallest amount that makes
+// the span [start, start+size), given relative to the visible page, visible
+func scrollAdjustmentToSpan(adjustment *C.GtkAdjustment, start, size float64) {
+	if adjustment == nil {
+		return
+	}
+
+	value := float64(C.gtk_adjustment_get_value(adjustment))
+	pageSize := float64(C.gtk_adjustment_get_page_size(adjustment))
+
+	switch {
+	case start < 0:
+		// Span begins above/left of the visible area
+		value += start
+	case start+size > pageSize:
+		// Span ends below/right of the visible area; never scroll past its start
+		delta := start + size - pageSize
+		if delta > start {
+			delta = start
+		}
+		value += delta
+	default:
+		// Already visible
+		return
+	}
+
+	// gtk_adjustment_set_value clamps to the valid range
+	C.gtk_adjustment_set_value(adjustment, C.double(value))
+}
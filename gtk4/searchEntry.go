@@ -0,0 +1,99 @@
+// Package gtk4 provides search entry functionality for GTK4
+// File: gtk4go/gtk4/searchEntry.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// Search entry signal types
+const (
+	SignalSearchChanged SignalType = "search-changed"
+	SignalStopSearch    SignalType = "stop-search"
+)
+
+// SearchEntryOption is a function that configures a search entry
+type SearchEntryOption func(*SearchEntry)
+
+// SearchEntry represents a GTK search entry, a text entry with a search icon
+// and a clear button that reports changes after a short delay
+type SearchEntry struct {
+	BaseWidget
+}
+
+// NewSearchEntry creates a new GTK search entry
+func NewSearchEntry(options ...SearchEntryOption) *SearchEntry {
+	entry := &SearchEntry{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_search_entry_new(),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(entry)
+	}
+
+	SetupFinalization(entry, entry.Destroy)
+	return entry
+}
+
+// WithSearchPlaceholderText sets the text shown while the search entry is empty
+func WithSearchPlaceholderText(text string) SearchEntryOption {
+	return func(e *SearchEntry) {
+		e.SetPlaceholderText(text)
+	}
+}
+
+// SetText sets the search text
+func (e *SearchEntry) SetText(text string) {
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+	C.gtk_editable_set_text((*C.GtkEditable)(unsafe.Pointer(e.widget)), cText)
+}
+
+// GetText gets the search text
+func (e *SearchEntry) GetText() string {
+	cText := C.gtk_editable_get_text((*C.GtkEditable)(unsafe.Pointer(e.widget)))
+	if cText == nil {
+		return ""
+	}
+	return C.GoString(cText)
+}
+
+// SetPlaceholderText sets the text shown while the search entry is empty
+func (e *SearchEntry) SetPlaceholderText(text string) {
+	WithCString(text, func(cText *C.char) {
+		C.gtk_search_entry_set_placeholder_text((*C.GtkSearchEntry)(unsafe.Pointer(e.widget)), cText)
+	})
+}
+
+// ConnectSearchChanged connects a callback for changes of the search text.
+// GTK delays the signal slightly so that it isn't emitted on every keystroke.
+func (e *SearchEntry) ConnectSearchChanged(callback func()) uint64 {
+	return Connect(e, SignalSearchChanged, callback)
+}
+
+// ConnectActivate connects a callback for when the user presses Enter
+func (e *SearchEntry) ConnectActivate(callback func()) uint64 {
+	return Connect(e, SignalActivate, callback)
+}
+
+// ConnectStopSearch connects a callback for when the user presses Escape
+func (e *SearchEntry) ConnectStopSearch(callback func()) uint64 {
+	return Connect(e, SignalStopSearch, callback)
+}
+
+// Destroy destroys the search entry and cleans up resources
+func (e *SearchEntry) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(e)
+
+	// Call base destroy method
+	e.BaseWidget.Destroy()
+}
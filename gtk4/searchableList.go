@@ -0,0 +1,390 @@
+// Package gtk4 provides a searchable list component for GTK4
+// File: gtk4go/gtk4/searchableList.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// extern gboolean searchableListFilterCallback(gpointer item, gpointer user_data);
+// extern void searchableListFilterDestroyNotify(gpointer user_data);
+// extern void searchableListItemsChangedCallback(GListModel *model, guint position, guint removed, guint added, gpointer user_data);
+//
+// // Create a filter model whose custom filter calls back into Go
+// static GtkFilterListModel* newSearchFilterModel(guint filterKey) {
+//     GtkCustomFilter *filter = gtk_custom_filter_new((GtkCustomFilterFunc)searchableListFilterCallback,
+//         GUINT_TO_POINTER(filterKey), searchableListFilterDestroyNotify);
+//     return gtk_filter_list_model_new(NULL, GTK_FILTER(filter));
+// }
+//
+// static void searchListSetupItem(GtkSignalListItemFactory *factory, GtkListItem *item, gpointer user_data) {
+//     GtkWidget *label = gtk_label_new(NULL);
+//     gtk_label_set_xalign(GTK_LABEL(label), 0.0);
+//     gtk_list_item_set_child(item, label);
+// }
+//
+// static void searchListBindItem(GtkSignalListItemFactory *factory, GtkListItem *item, gpointer user_data) {
+//     GtkStringObject *obj = GTK_STRING_OBJECT(gtk_list_item_get_item(item));
+//     gtk_label_set_text(GTK_LABEL(gtk_list_item_get_child(item)), obj != NULL ? gtk_string_object_get_string(obj) : "");
+// }
+//
+// // Create the list view for a filter model. Rows are plain labels that are set
+// // up and bound entirely in C, so scrolling never calls into Go.
+// static GtkWidget* newSearchListView(GtkFilterListModel *filterModel) {
+//     GtkListItemFactory *factory = gtk_signal_list_item_factory_new();
+//     g_signal_connect(factory, "setup", G_CALLBACK(searchListSetupItem), NULL);
+//     g_signal_connect(factory, "bind", G_CALLBACK(searchListBindItem), NULL);
+//     GtkSingleSelection *selection = gtk_single_selection_new(G_LIST_MODEL(g_object_ref(filterModel)));
+//     return gtk_list_view_new(GTK_SELECTION_MODEL(selection), factory);
+// }
+//
+// static gulong connectSearchItemsChanged(GtkFilterListModel *filterModel, guint callbackId) {
+//     return g_signal_connect(filterModel, "items-changed", G_CALLBACK(searchableListItemsChangedCallback), GUINT_TO_POINTER(callbackId));
+// }
+//
+// // Get the string of a GtkStringObject at a position; free with g_free
+// static char* searchModelGetString(GListModel *model, guint position) {
+//     char *result = NULL;
+//     GObject *item = g_list_model_get_item(model, position);
+//     if (item != NULL) {
+//         if (GTK_IS_STRING_OBJECT(item)) {
+//             result = g_strdup(gtk_string_object_get_string(GTK_STRING_OBJECT(item)));
+//         }
+//         g_object_unref(item);
+//     }
+//     return result;
+// }
+//
+// static const char* searchItemGetString(gpointer item) {
+//     return GTK_IS_STRING_OBJECT(item) ? gtk_string_object_get_string(GTK_STRING_OBJECT(item)) : NULL;
+// }
+import "C"
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// SearchFilterFunc decides whether an item matches the search text.
+// It runs on the UI thread for each item whenever the search text changes.
+type SearchFilterFunc func(item, query string) bool
+
+// SearchCountFormatFunc formats the result count label from the number of
+// items shown and the total number of items
+type SearchCountFormatFunc func(shown, total int) string
+
+// searchFilter holds the state the custom filter reads while filtering
+type searchFilter struct {
+	mu         sync.RWMutex
+	query      string
+	filterFunc SearchFilterFunc
+}
+
+var (
+	// searchFilters maps filter keys to the state of SearchableList filters
+	searchFilters       sync.Map
+	nextSearchFilterKey atomic.Uint64
+)
+
+// DefaultSearchFilter matches items that contain the query, ignoring case.
+// An empty query matches every item.
+func DefaultSearchFilter(item, query string) bool {
+	return query == "" || strings.Contains(strings.ToLower(item), strings.ToLower(query))
+}
+
+// defaultSearchCountFormat formats the result count as "N items" or "N of M items"
+func defaultSearchCountFormat(shown, total int) string {
+	if shown == total {
+		return fmt.Sprintf("%d items", total)
+	}
+	return fmt.Sprintf("%d of %d items", shown, total)
+}
+
+// SearchableListOption is a function that configures a searchable list
+type SearchableListOption func(*SearchableList)
+
+// SearchableList is a composite widget with a search entry, a filtered list
+// of strings and a label showing the number of results. Filtering happens in
+// the list model, so typing doesn't rebuild any widgets.
+type SearchableList struct {
+	Box
+	entry       *SearchEntry
+	scrolled    *ScrolledWindow
+	listView    *ListView
+	countLabel  *Label
+	items       *StringList
+	filterModel *C.GtkFilterListModel
+	filtered    *BaseListModel
+	filter      *searchFilter
+	countFormat SearchCountFormatFunc
+}
+
+// NewSearchableList creates a new searchable list with the given items
+func NewSearchableList(items []string, options ...SearchableListOption) *SearchableList {
+	filter := &searchFilter{filterFunc: DefaultSearchFilter}
+	filterKey := nextSearchFilterKey.Add(1)
+	searchFilters.Store(filterKey, filter)
+
+	filterModel := C.newSearchFilterModel(C.guint(filterKey))
+
+	sl := &SearchableList{
+		Box: Box{
+			BaseWidget: BaseWidget{
+				widget: C.gtk_box_new(C.GTK_ORIENTATION_VERTICAL, 6),
+			},
+		},
+		entry:       NewSearchEntry(),
+		scrolled:    NewScrolledWindow(WithVExpand(true)),
+		countLabel:  NewLabel(""),
+		items:       NewStringList(),
+		filterModel: filterModel,
+		filtered:    &BaseListModel{model: (*C.GListModel)(unsafe.Pointer(filterModel))},
+		filter:      filter,
+		countFormat: defaultSearchCountFormat,
+	}
+	sl.AddCssClass("searchable-list")
+
+	sl.listView = &ListView{
+		BaseWidget: BaseWidget{
+			widget: C.newSearchListView(filterModel),
+		},
+	}
+
+	sl.countLabel.AddCssClass("dim-label")
+	sl.countLabel.SetHAlign(AlignStart)
+
+	sl.scrolled.SetChild(sl.listView)
+	sl.Append(sl.entry)
+	sl.Append(sl.scrolled)
+	sl.Append(sl.countLabel)
+
+	C.gtk_filter_list_model_set_model(filterModel, sl.items.GetListModel())
+	sl.SetItems(items)
+
+	sl.entry.ConnectSearchChanged(sl.onSearchChanged)
+	connectCustomSignal(sl.filtered, SignalItemsChanged, sl.updateCount, func(id C.guint) C.gulong {
+		return C.connectSearchItemsChanged(filterModel, id)
+	})
+
+	// Apply options
+	for _, option := range options {
+		option(sl)
+	}
+
+	sl.updateCount()
+
+	SetupFinalization(sl, sl.Destroy)
+	return sl
+}
+
+// WithSearchableListPlaceholder sets the text shown in the empty search entry
+func WithSearchableListPlaceholder(text string) SearchableListOption {
+	return func(sl *SearchableList) {
+		sl.entry.SetPlaceholderText(text)
+	}
+}
+
+// WithSearchableListFilterFunc sets the function that matches items against the search text
+func WithSearchableListFilterFunc(filterFunc SearchFilterFunc) SearchableListOption {
+	return func(sl *SearchableList) {
+		sl.SetFilterFunc(filterFunc)
+	}
+}
+
+// WithSearchableListCountFormat sets the function that formats the result count label
+func WithSearchableListCountFormat(format SearchCountFormatFunc) SearchableListOption {
+	return func(sl *SearchableList) {
+		sl.SetCountFormat(format)
+	}
+}
+
+// SetItems replaces the items of the list. The current search text is kept
+// and applied to the new items.
+func (sl *SearchableList) SetItems(items []string) {
+	sl.items.Splice(0, sl.items.GetNItems(), items)
+}
+
+// SetModel shows the items of a string list instead of the list's own items.
+// The model is shared, so later changes to it are reflected in the list.
+func (sl *SearchableList) SetModel(model *StringList) {
+	if model == nil {
+		model = NewStringList()
+	}
+	sl.items = model
+	C.gtk_filter_list_model_set_model(sl.filterModel, model.GetListModel())
+	sl.updateCount()
+}
+
+// GetModel returns the string list whose items are shown
+func (sl *SearchableList) GetModel() *StringList {
+	return sl.items
+}
+
+// SetFilterFunc sets the function that matches items against the search
+// text. Passing nil restores DefaultSearchFilter.
+func (sl *SearchableList) SetFilterFunc(filterFunc SearchFilterFunc) {
+	if filterFunc == nil {
+		filterFunc = DefaultSearchFilter
+	}
+
+	sl.filter.mu.Lock()
+	sl.filter.filterFunc = filterFunc
+	sl.filter.mu.Unlock()
+
+	sl.refilter()
+}
+
+// SetCountFormat sets the function that formats the result count label.
+// Passing nil restores the default "N items" / "N of M items" format.
+func (sl *SearchableList) SetCountFormat(format SearchCountFormatFunc) {
+	if format == nil {
+		format = defaultSearchCountFormat
+	}
+	sl.countFormat = format
+	sl.updateCount()
+}
+
+// SetSearchText sets the search text and filters the list immediately
+func (sl *SearchableList) SetSearchText(text string) {
+	sl.entry.SetText(text)
+	sl.onSearchChanged()
+}
+
+// GetSearchText returns the current search text
+func (sl *SearchableList) GetSearchText() string {
+	return sl.entry.GetText()
+}
+
+// GetResultCount returns the number of items matching the search text
+func (sl *SearchableList) GetResultCount() int {
+	return sl.filtered.GetNItems()
+}
+
+// GetSearchEntry returns the search entry of the list
+func (sl *SearchableList) GetSearchEntry() *SearchEntry {
+	return sl.entry
+}
+
+// GetListView returns the list view showing the matching items
+func (sl *SearchableList) GetListView() *ListView {
+	return sl.listView
+}
+
+// ConnectActivate connects a callback for when the user activates a
+// matching item, e.g. by double-clicking it or pressing Enter
+func (sl *SearchableList) ConnectActivate(callback func(item string)) uint64 {
+	return Connect(sl.listView, SignalListActivate, func(position int) {
+		callback(sl.itemAt(position))
+	})
+}
+
+// itemAt returns the matching item at a position of the filtered list
+func (sl *SearchableList) itemAt(position int) string {
+	cItem := C.searchModelGetString(sl.filtered.GetListModel(), C.guint(position))
+	if cItem == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(unsafe.Pointer(cItem)))
+	return C.GoString(cItem)
+}
+
+// onSearchChanged applies the current search text to the filter
+func (sl *SearchableList) onSearchChanged() {
+	query := sl.entry.GetText()
+
+	sl.filter.mu.Lock()
+	changed := query != sl.filter.query
+	sl.filter.query = query
+	sl.filter.mu.Unlock()
+
+	if changed {
+		sl.refilter()
+	}
+}
+
+// refilter makes the filter model re-evaluate every item
+func (sl *SearchableList) refilter() {
+	filter := C.gtk_filter_list_model_get_filter(sl.filterModel)
+	C.gtk_filter_changed(filter, C.GTK_FILTER_CHANGE_DIFFERENT)
+}
+
+// updateCount updates the result count label
+func (sl *SearchableList) updateCount() {
+	if sl.countLabel == nil || sl.items == nil {
+		return
+	}
+	sl.countLabel.SetText(sl.countFormat(sl.filtered.GetNItems(), sl.items.GetNItems()))
+}
+
+// Destroy destroys the searchable list and cleans up resources
+func (sl *SearchableList) Destroy() {
+	if sl.filterModel == nil {
+		return
+	}
+
+	// Disconnect all signals of the component and its parts
+	DisconnectAll(sl)
+	DisconnectAll(sl.entry)
+	DisconnectAll(sl.listView)
+	DisconnectAll(sl.filtered)
+
+	// The parts are owned by the box; clear their pointers so their own
+	// finalizers don't unparent widgets that are destroyed with the box
+	sl.entry.widget = nil
+	sl.scrolled.widget = nil
+	sl.listView.widget = nil
+	sl.countLabel.widget = nil
+
+	// Releasing the filter model releases the filter, whose destroy notify
+	// removes its Go state
+	C.gtk_filter_list_model_set_model(sl.filterModel, nil)
+	C.g_object_unref(C.gpointer(unsafe.Pointer(sl.filterModel)))
+	sl.filterModel = nil
+	sl.filtered.model = nil
+
+	// Call base destroy method
+	sl.Box.Destroy()
+}
+
+//export searchableListFilterCallback
+func searchableListFilterCallback(item C.gpointer, userData C.gpointer) C.gboolean {
+	value, ok := searchFilters.Load(uint64(uintptr(userData)))
+	if !ok {
+		return C.TRUE
+	}
+	filter := value.(*searchFilter)
+
+	var text string
+	if cText := C.searchItemGetString(item); cText != nil {
+		text = C.GoString(cText)
+	}
+
+	filter.mu.RLock()
+	query, filterFunc := filter.query, filter.filterFunc
+	filter.mu.RUnlock()
+
+	if filterFunc(text, query) {
+		return C.TRUE
+	}
+	return C.FALSE
+}
+
+//export searchableListFilterDestroyNotify
+func searchableListFilterDestroyNotify(userData C.gpointer) {
+	searchFilters.Delete(uint64(uintptr(userData)))
+}
+
+//export searchableListItemsChangedCallback
+func searchableListItemsChangedCallback(model *C.GListModel, position, removed, added C.guint, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	if cb, ok := callback.(func()); ok {
+		SafeCallback(cb)
+	}
+}
@@ -8,6 +8,7 @@ package gtk4
 //
 // // Selection model callbacks
 // extern void selectionChangedCallback(GtkSelectionModel *model, guint position, guint n_items, gpointer user_data);
+// extern void singleSelectionSelectedCallback(GObject *object, GParamSpec *pspec, gpointer user_data);
 //
 // // Connect selection changed signal
 // static gulong connectSelectionChanged(GtkSelectionModel *model, gpointer user_data) {
@@ -23,12 +24,13 @@ package gtk4
 //     gtk_single_selection_set_model(selection, model);
 // }
 //
-// static void setSingleSelectionSelected(GtkSingleSelection *selection, guint position) {
-//     gtk_single_selection_set_selected(selection, position);
+// static void setSingleSelectionSelected(GtkSingleSelection *selection, int position) {
+//     gtk_single_selection_set_selected(selection, position < 0 ? GTK_INVALID_LIST_POSITION : (guint)position);
 // }
 //
-// static guint getSingleSelectionSelected(GtkSingleSelection *selection) {
-//     return gtk_single_selection_get_selected(selection);
+// static int getSingleSelectionSelected(GtkSingleSelection *selection) {
+//     guint selected = gtk_single_selection_get_selected(selection);
+//     return selected == GTK_INVALID_LIST_POSITION ? -1 : (int)selected;
 // }
 //
 // static void setSingleSelectionAutoselect(GtkSingleSelection *selection, gboolean autoselect) {
@@ -39,6 +41,10 @@ package gtk4
 //     return gtk_single_selection_get_autoselect(selection);
 // }
 //
+// static gulong connectSingleSelectionSelected(GtkSingleSelection *selection, guint callbackId) {
+//     return g_signal_connect(selection, "notify::selected", G_CALLBACK(singleSelectionSelectedCallback), GUINT_TO_POINTER(callbackId));
+// }
+//
 // // MultiSelection operations
 // static GtkMultiSelection* createMultiSelection(GListModel *model) {
 //     return gtk_multi_selection_new(model);
@@ -203,6 +209,35 @@ func (m *BaseSelectionModel) UnselectAll() {
 	C.selectionModelUnselectAll(m.selectionModel)
 }
 
+// GetSelection returns a snapshot of the selected positions. The bitset
+// doesn't change when the selection does.
+func (m *BaseSelectionModel) GetSelection() *Bitset {
+	return wrapBitset(C.selectionModelGetSelection(m.selectionModel))
+}
+
+// GetSelectedItems returns the selected positions in ascending order, or an
+// empty slice if nothing is selected
+func (m *BaseSelectionModel) GetSelectedItems() []int {
+	selection := m.GetSelection()
+	if selection == nil {
+		return []int{}
+	}
+	defer selection.Destroy()
+
+	return selection.ToSlice()
+}
+
+// GetSelectionSize returns the number of selected items
+func (m *BaseSelectionModel) GetSelectionSize() int {
+	selection := m.GetSelection()
+	if selection == nil {
+		return 0
+	}
+	defer selection.Destroy()
+
+	return selection.GetSize()
+}
+
 // GetItem returns the item at the given position by delegating to the source model
 func (m *BaseSelectionModel) GetItem(position int) interface{} {
 	if m.sourceModel != nil {
@@ -299,7 +334,7 @@ func WithAutoselect(autoselect bool) SingleSelectionOption {
 // WithInitialSelection sets the initially selected item
 func WithInitialSelection(position int) SingleSelectionOption {
 	return func(s *SingleSelection) {
-		C.setSingleSelectionSelected(s.singleSelection, C.guint(position))
+		C.setSingleSelectionSelected(s.singleSelection, C.int(position))
 	}
 }
 
@@ -314,14 +349,47 @@ func (s *SingleSelection) SetModel(model ListModel) {
 	}
 }
 
-// GetSelected returns the position of the selected item
+// GetSelected returns the position of the selected item, or -1 if nothing is selected
 func (s *SingleSelection) GetSelected() int {
 	return int(C.getSingleSelectionSelected(s.singleSelection))
 }
 
-// SetSelected sets the selected item
+// SetSelected selects the item at the given position; -1 clears the selection
 func (s *SingleSelection) SetSelected(position int) {
-	C.setSingleSelectionSelected(s.singleSelection, C.guint(position))
+	C.setSingleSelectionSelected(s.singleSelection, C.int(position))
+}
+
+// GetSelectedItem returns the selected item as returned by the source
+// model's GetItem, or nil if nothing is selected
+func (s *SingleSelection) GetSelectedItem() interface{} {
+	return s.itemAt(s.GetSelected())
+}
+
+// itemAt resolves a position to the source model's Go value
+func (s *SingleSelection) itemAt(position int) interface{} {
+	if position < 0 {
+		return nil
+	}
+	return s.GetItem(position)
+}
+
+// ConnectSelectedChanged connects a callback for changes of the selected
+// position, including GTK moving or re-selecting the item when the source
+// model changes (e.g. with autoselect on and the selected item removed).
+// The position is -1 when nothing is selected.
+func (s *SingleSelection) ConnectSelectedChanged(callback func(position int)) uint64 {
+	return connectCustomSignal(s, SignalSelectedChanged, callback, func(id C.guint) C.gulong {
+		return C.connectSingleSelectionSelected(s.singleSelection, id)
+	})
+}
+
+// ConnectSelectedItemChanged connects a callback for changes of the selected
+// item, which is passed as returned by the source model's GetItem, or nil
+// when nothing is selected
+func (s *SingleSelection) ConnectSelectedItemChanged(callback func(item interface{})) uint64 {
+	return s.ConnectSelectedChanged(func(position int) {
+		callback(s.itemAt(position))
+	})
 }
 
 // SetAutoselect sets whether the selection should automatically select an item
@@ -455,3 +523,18 @@ func (s *NoSelection) Destroy() {
 	s.noSelection = nil
 }
 
+//export singleSelectionSelectedCallback
+func singleSelectionSelectedCallback(object *C.GObject, pspec *C.GParamSpec, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	if cb, ok := callback.(func(int)); ok {
+		selected := int(C.getSingleSelectionSelected((*C.GtkSingleSelection)(unsafe.Pointer(object))))
+		SafeCallback(cb, selected)
+	} else {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"singleSelectionSelectedCallback: callback has wrong type: %T", callback)
+	}
+}
@@ -0,0 +1,247 @@
+// Package gtk4 provides separator functionality for GTK4
+// File: gtk4go/gtk4/separator.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// // Create a horizontal row of a separator line, a centered label and another
+// // separator line. The row fills the available width and exposes the label
+// // text as its accessible name.
+// static GtkWidget* createLabeledSeparator(const char *text) {
+//     GtkWidget *box = gtk_box_new(GTK_ORIENTATION_HORIZONTAL, 6);
+//     gtk_widget_set_hexpand(box, TRUE);
+//     gtk_widget_add_css_class(box, "labeled-separator");
+//
+//     GtkWidget *start = gtk_separator_new(GTK_ORIENTATION_HORIZONTAL);
+//     gtk_widget_set_hexpand(start, TRUE);
+//     gtk_widget_set_valign(start, GTK_ALIGN_CENTER);
+//     gtk_box_append(GTK_BOX(box), start);
+//
+//     GtkWidget *label = gtk_label_new(text);
+//     gtk_widget_add_css_class(label, "dim-label");
+//     gtk_box_append(GTK_BOX(box), label);
+//
+//     GtkWidget *end = gtk_separator_new(GTK_ORIENTATION_HORIZONTAL);
+//     gtk_widget_set_hexpand(end, TRUE);
+//     gtk_widget_set_valign(end, GTK_ALIGN_CENTER);
+//     gtk_box_append(GTK_BOX(box), end);
+//
+//     gtk_accessible_update_property(GTK_ACCESSIBLE(box), GTK_ACCESSIBLE_PROPERTY_LABEL, text, -1);
+//     return box;
+// }
+//
+// static GtkLabel* labeledSeparatorLabel(GtkWidget *box) {
+//     return GTK_LABEL(gtk_widget_get_next_sibling(gtk_widget_get_first_child(box)));
+// }
+//
+// static void labeledSeparatorSetText(GtkWidget *box, const char *text) {
+//     gtk_label_set_text(labeledSeparatorLabel(box), text);
+//     gtk_accessible_update_property(GTK_ACCESSIBLE(box), GTK_ACCESSIBLE_PROPERTY_LABEL, text, -1);
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+// SeparatorPreset defines a named separator style for common uses
+type SeparatorPreset int
+
+const (
+	// SeparatorPresetToolbar separates groups of toolbar buttons
+	SeparatorPresetToolbar SeparatorPreset = iota
+	// SeparatorPresetMenu separates groups of items in menus and popovers
+	SeparatorPresetMenu
+	// SeparatorPresetSection separates sections of a page or form
+	SeparatorPresetSection
+)
+
+// separatorPresets maps presets to their CSS class and the space on each
+// side of the separator line, in pixels
+var separatorPresets = map[SeparatorPreset]struct {
+	cssClass string
+	spacing  int
+}{
+	SeparatorPresetToolbar: {"toolbar-separator", 4},
+	SeparatorPresetMenu:    {"menu-separator", 6},
+	SeparatorPresetSection: {"section-separator", 12},
+}
+
+// SeparatorOption is a function that configures a separator
+type SeparatorOption func(*Separator)
+
+// Separator represents a GTK separator line. A horizontal separator is a
+// horizontal line that fills the available width; a vertical separator
+// fills the available height.
+type Separator struct {
+	BaseWidget
+}
+
+// NewSeparator creates a new GTK separator with the given orientation
+func NewSeparator(orientation Orientation, options ...SeparatorOption) *Separator {
+	separator := &Separator{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_separator_new(C.GtkOrientation(orientation)),
+		},
+	}
+
+	// Stretch along the line so the separator spans its container in
+	// either orientation
+	if orientation == OrientationVertical {
+		separator.SetVAlign(AlignFill)
+	} else {
+		separator.SetHAlign(AlignFill)
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(separator)
+	}
+
+	SetupFinalization(separator, separator.Destroy)
+	return separator
+}
+
+// WithSeparatorCssClass adds a CSS class to the separator
+func WithSeparatorCssClass(className string) SeparatorOption {
+	return func(s *Separator) {
+		s.AddCssClass(className)
+	}
+}
+
+// WithSeparatorPreset applies a named preset, which adds the preset's CSS
+// class and spacing around the separator line
+func WithSeparatorPreset(preset SeparatorPreset) SeparatorOption {
+	return func(s *Separator) {
+		s.ApplyPreset(preset)
+	}
+}
+
+// WithSeparatorSpacing sets the space on each side of the separator line
+func WithSeparatorSpacing(spacing int) SeparatorOption {
+	return func(s *Separator) {
+		s.SetSpacing(spacing)
+	}
+}
+
+// WithSeparatorDim draws the separator line with reduced contrast
+func WithSeparatorDim() SeparatorOption {
+	return func(s *Separator) {
+		s.SetDim(true)
+	}
+}
+
+// WithSeparatorThickness sets the thickness of the separator line in pixels
+func WithSeparatorThickness(thickness int) SeparatorOption {
+	return func(s *Separator) {
+		s.SetThickness(thickness)
+	}
+}
+
+// SetOrientation sets the orientation of the separator
+func (s *Separator) SetOrientation(orientation Orientation) {
+	C.gtk_orientable_set_orientation((*C.GtkOrientable)(unsafe.Pointer(s.widget)), C.GtkOrientation(orientation))
+}
+
+// GetOrientation gets the orientation of the separator
+func (s *Separator) GetOrientation() Orientation {
+	return Orientation(C.gtk_orientable_get_orientation((*C.GtkOrientable)(unsafe.Pointer(s.widget))))
+}
+
+// SetSpacing sets the space on each side of the separator line: above and
+// below a horizontal separator, or before and after a vertical one
+func (s *Separator) SetSpacing(spacing int) {
+	if s.GetOrientation() == OrientationVertical {
+		C.gtk_widget_set_margin_start(s.widget, C.int(spacing))
+		C.gtk_widget_set_margin_end(s.widget, C.int(spacing))
+	} else {
+		C.gtk_widget_set_margin_top(s.widget, C.int(spacing))
+		C.gtk_widget_set_margin_bottom(s.widget, C.int(spacing))
+	}
+}
+
+// SetDim sets whether the separator line is drawn with reduced contrast,
+// using the theme's "dim-label" style class
+func (s *Separator) SetDim(dim bool) {
+	if dim {
+		s.AddCssClass("dim-label")
+	} else {
+		s.RemoveCssClass("dim-label")
+	}
+}
+
+// SetThickness sets the thickness of the separator line in pixels. The theme
+// draws the line across the separator's whole allocation, so a larger
+// minimum size makes a wider line.
+func (s *Separator) SetThickness(thickness int) {
+	if s.GetOrientation() == OrientationVertical {
+		C.gtk_widget_set_size_request(s.widget, C.int(thickness), -1)
+	} else {
+		C.gtk_widget_set_size_request(s.widget, -1, C.int(thickness))
+	}
+}
+
+// ApplyPreset applies a named preset's CSS class and spacing
+func (s *Separator) ApplyPreset(preset SeparatorPreset) {
+	style, ok := separatorPresets[preset]
+	if !ok {
+		return
+	}
+
+	s.AddCssClass(style.cssClass)
+	s.SetSpacing(style.spacing)
+}
+
+// Destroy destroys the separator and cleans up resources
+func (s *Separator) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(s)
+
+	// Call base destroy method
+	s.BaseWidget.Destroy()
+}
+
+// LabeledSeparator represents a horizontal separator with a centered label,
+// used as a section divider. It expands horizontally and exposes its text
+// to assistive technologies.
+type LabeledSeparator struct {
+	BaseWidget
+}
+
+// NewLabeledSeparator creates a new labeled separator with the given text
+func NewLabeledSeparator(text string) *LabeledSeparator {
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+
+	separator := &LabeledSeparator{
+		BaseWidget: BaseWidget{
+			widget: C.createLabeledSeparator(cText),
+		},
+	}
+
+	SetupFinalization(separator, separator.Destroy)
+	return separator
+}
+
+// SetText sets the text of the label
+func (s *LabeledSeparator) SetText(text string) {
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+	C.labeledSeparatorSetText(s.widget, cText)
+}
+
+// GetText returns the text of the label
+func (s *LabeledSeparator) GetText() string {
+	return C.GoString(C.gtk_label_get_text(C.labeledSeparatorLabel(s.widget)))
+}
+
+// Destroy destroys the labeled separator and cleans up resources
+func (s *LabeledSeparator) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(s)
+
+	// Call base destroy method
+	s.BaseWidget.Destroy()
+}
@@ -0,0 +1,105 @@
+// Package gtk4 provides GTK settings functionality for GTK4
+// File: gtk4go/gtk4/settings.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// Settings property names
+const (
+	// SettingPreferDarkTheme is the property that makes themes use their dark variant
+	SettingPreferDarkTheme = "gtk-application-prefer-dark-theme"
+	// SettingThemeName is the property holding the name of the GTK theme
+	SettingThemeName = "gtk-theme-name"
+)
+
+// Settings provides access to the GTK settings of a display. Changes apply
+// to all windows on the display.
+type Settings struct {
+	settings *C.GtkSettings
+}
+
+// GetDefaultSettings returns the settings of the default display, or nil if
+// there is no display. The settings are owned by GTK and live as long as the display.
+func GetDefaultSettings() *Settings {
+	settings := C.gtk_settings_get_default()
+	if settings == nil {
+		return nil
+	}
+	return &Settings{settings: settings}
+}
+
+// Native returns the underlying GtkSettings pointer as uintptr
+func (s *Settings) Native() uintptr {
+	return uintptr(unsafe.Pointer(s.settings))
+}
+
+// object returns the settings as a GObject
+func (s *Settings) object() *C.GObject {
+	return (*C.GObject)(unsafe.Pointer(s.settings))
+}
+
+// SetPreferDarkTheme sets whether the theme's dark variant is used, e.g. for
+// a dark mode toggle. CSS added with AddProviderForDisplay at the application
+// priority still overrides the theme in either variant.
+func (s *Settings) SetPreferDarkTheme(preferDark bool) {
+	if err := setObjectProperty(s.object(), SettingPreferDarkTheme, preferDark); err != nil {
+		DebugLog(DebugLevelError, DebugComponentGeneral, "SetPreferDarkTheme: %v", err)
+	}
+}
+
+// GetPreferDarkTheme returns whether the theme's dark variant is used
+func (s *Settings) GetPreferDarkTheme() bool {
+	value, err := getObjectProperty(s.object(), SettingPreferDarkTheme)
+	if err != nil {
+		DebugLog(DebugLevelError, DebugComponentGeneral, "GetPreferDarkTheme: %v", err)
+		return false
+	}
+	preferDark, _ := value.(bool)
+	return preferDark
+}
+
+// SetThemeName sets the name of the GTK theme, e.g. "Adwaita"
+func (s *Settings) SetThemeName(name string) {
+	if err := setObjectProperty(s.object(), SettingThemeName, name); err != nil {
+		DebugLog(DebugLevelError, DebugComponentGeneral, "SetThemeName: %v", err)
+	}
+}
+
+// GetThemeName returns the name of the GTK theme
+func (s *Settings) GetThemeName() string {
+	value, err := getObjectProperty(s.object(), SettingThemeName)
+	if err != nil {
+		DebugLog(DebugLevelError, DebugComponentGeneral, "GetThemeName: %v", err)
+		return ""
+	}
+	name, _ := value.(string)
+	return name
+}
+
+// ConnectNotify connects a callback for changes of a settings property, such
+// as SettingPreferDarkTheme. The callback is either a func() or receives the
+// new value as func(bool), func(string) or func(float64), matching the
+// property's type.
+func (s *Settings) ConnectNotify(property string, callback interface{}) uint64 {
+	// Property notifications carry a parameter, so callbacks without one are
+	// adapted to the parameterized form
+	if cb, ok := callback.(func()); ok {
+		callback = func(interface{}) {
+			cb()
+		}
+	}
+	return Connect(s, SignalType("notify::"+property), callback)
+}
+
+// ConnectPreferDarkThemeChanged connects a callback for changes of the
+// dark theme preference, whether made by the application or the system
+func (s *Settings) ConnectPreferDarkThemeChanged(callback func(preferDark bool)) uint64 {
+	return s.ConnectNotify(SettingPreferDarkTheme, callback)
+}
@@ -0,0 +1,319 @@
+// Package gtk4 provides sparkline functionality for GTK4
+// File: gtk4go/gtk4/sparkline.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// extern void sparklineDrawCallback(GtkDrawingArea *area, cairo_t *cr, int width, int height, gpointer user_data);
+//
+// // The sparkline is looked up by its widget, so no user data is needed
+// static void setSparklineDrawFunc(GtkDrawingArea *area) {
+//     gtk_drawing_area_set_draw_func(area, sparklineDrawCallback, NULL, NULL);
+// }
+//
+// extern void sparklineItemNotifyCallback(GObject *item, GParamSpec *pspec, gpointer user_data);
+//
+// // Update the sparkline whenever a property of its bound item changes. The
+// // handler is disconnected automatically if the sparkline is freed first.
+// static void watchSparklineItem(gpointer item, GtkWidget *sparkline) {
+//     g_signal_connect_object(item, "notify", G_CALLBACK(sparklineItemNotifyCallback), sparkline, 0);
+// }
+//
+// static void unwatchSparklineItem(gpointer item, GtkWidget *sparkline) {
+//     g_signal_handlers_disconnect_by_func(item, (gpointer)sparklineItemNotifyCallback, sparkline);
+// }
+//
+// // Get the widget's CSS foreground color
+// static void getWidgetColor(GtkWidget *widget, double *r, double *g, double *b, double *a) {
+//     GdkRGBA color;
+//     gtk_widget_get_color(widget, &color);
+//     *r = color.red;
+//     *g = color.green;
+//     *b = color.blue;
+//     *a = color.alpha;
+// }
+import "C"
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/justyntemme/gtk4go/core/uithread"
+)
+
+// Default size of a sparkline in pixels
+const (
+	defaultSparklineWidth  = 80
+	defaultSparklineHeight = 20
+)
+
+// sparklines maps sparkline widgets to their Go wrappers, so that the draw
+// callback and the list item factory callbacks can find them
+var sparklines sync.Map
+
+// SparklineOption is a function that configures a sparkline
+type SparklineOption func(*Sparkline)
+
+// Sparkline is a small line graph without axes, suitable for showing a trend
+// inline, for example in a list row. The line is drawn in the widget's CSS
+// foreground color, so it can be styled with the "color" property.
+type Sparkline struct {
+	BaseWidget
+	mu       sync.Mutex
+	values   []float64
+	min, max float64
+	hasRange bool
+
+	// Set while the sparkline is bound to a list item by SparklineFactory
+	item   C.gpointer
+	update func()
+}
+
+// NewSparkline creates a new sparkline
+func NewSparkline(options ...SparklineOption) *Sparkline {
+	sparkline := &Sparkline{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_drawing_area_new(),
+		},
+	}
+
+	sparkline.SetSize(defaultSparklineWidth, defaultSparklineHeight)
+	sparkline.AddCssClass("sparkline")
+	sparklines.Store(uintptr(unsafe.Pointer(sparkline.widget)), sparkline)
+	C.setSparklineDrawFunc(sparkline.drawingArea())
+
+	// Apply options
+	for _, option := range options {
+		option(sparkline)
+	}
+
+	SetupFinalization(sparkline, sparkline.Destroy)
+	return sparkline
+}
+
+// WithSparklineRange sets a fixed value range for the sparkline
+func WithSparklineRange(min, max float64) SparklineOption {
+	return func(s *Sparkline) {
+		s.SetRange(min, max)
+	}
+}
+
+// WithSparklineSize sets the preferred size of the sparkline
+func WithSparklineSize(width, height int) SparklineOption {
+	return func(s *Sparkline) {
+		s.SetSize(width, height)
+	}
+}
+
+// drawingArea returns the widget as a GtkDrawingArea
+func (s *Sparkline) drawingArea() *C.GtkDrawingArea {
+	return (*C.GtkDrawingArea)(unsafe.Pointer(s.widget))
+}
+
+// SetSize sets the preferred size of the sparkline in pixels
+func (s *Sparkline) SetSize(width, height int) {
+	C.gtk_drawing_area_set_content_width(s.drawingArea(), C.int(width))
+	C.gtk_drawing_area_set_content_height(s.drawingArea(), C.int(height))
+}
+
+// SetValues sets the values plotted by the sparkline and schedules a redraw.
+// It is safe to call from any goroutine.
+func (s *Sparkline) SetValues(values []float64) {
+	s.mu.Lock()
+	s.values = append(s.values[:0], values...)
+	s.mu.Unlock()
+
+	s.queueDraw()
+}
+
+// SetRange sets a fixed value range for the sparkline, such as 0 to 100 for
+// percentages. Without a fixed range the sparkline scales to its values.
+func (s *Sparkline) SetRange(min, max float64) {
+	s.mu.Lock()
+	s.min, s.max = min, max
+	s.hasRange = max > min
+	s.mu.Unlock()
+
+	s.queueDraw()
+}
+
+// queueDraw schedules a redraw on the UI thread
+func (s *Sparkline) queueDraw() {
+	RunOnUIThread(func() {
+		if s.widget != nil {
+			C.gtk_widget_queue_draw(s.widget)
+		}
+	})
+}
+
+// draw plots the values as a line across the full width of the widget
+func (s *Sparkline) draw(cr *C.cairo_t, width, height int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.values) < 2 || width <= 0 || height <= 0 {
+		return
+	}
+
+	// Determine the vertical scale
+	min, max := s.min, s.max
+	if !s.hasRange {
+		min, max = s.values[0], s.values[0]
+		for _, v := range s.values {
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+		}
+	}
+	span := max - min
+
+	// Keep the line inside the widget
+	const lineWidth = 1.5
+	top := lineWidth / 2
+	plotHeight := float64(height) - lineWidth
+	step := float64(width) / float64(len(s.values)-1)
+
+	for i, v := range s.values {
+		// Flat data is drawn through the middle
+		fraction := 0.5
+		if span > 0 {
+			fraction = (v - min) / span
+			if fraction < 0 {
+				fraction = 0
+			} else if fraction > 1 {
+				fraction = 1
+			}
+		}
+
+		x := float64(i) * step
+		y := top + plotHeight*(1-fraction)
+		if i == 0 {
+			C.cairo_move_to(cr, C.double(x), C.double(y))
+		} else {
+			C.cairo_line_to(cr, C.double(x), C.double(y))
+		}
+	}
+
+	var r, g, b, a C.double
+	C.getWidgetColor(s.widget, &r, &g, &b, &a)
+	C.cairo_set_source_rgba(cr, r, g, b, a)
+	C.cairo_set_line_width(cr, lineWidth)
+	C.cairo_stroke(cr)
+}
+
+// Destroy destroys the sparkline and cleans up resources
+func (s *Sparkline) Destroy() {
+	if s.widget != nil {
+		sparklines.Delete(uintptr(unsafe.Pointer(s.widget)))
+	}
+
+	// Disconnect all signals for this widget
+	DisconnectAll(s)
+
+	// Call base destroy method
+	s.BaseWidget.Destroy()
+}
+
+// SparklineFactory creates a list item factory that shows a sparkline in each
+// row of a ListView or ColumnView. getValues is called with the row's item
+// when the row is bound, and again whenever a property of the item changes,
+// so that the sparkline follows its item. Items without properties, or whose
+// values change without notification, can be redrawn by rebinding the row,
+// e.g. by replacing the item in the model. The options are applied to every
+// sparkline.
+func SparklineFactory(getValues func(item interface{}) []float64, options ...SparklineOption) *SignalListItemFactory {
+	factory := NewSignalListItemFactory()
+
+	factory.ConnectSetup(func(listItem *ListItem) {
+		listItem.SetChild(NewSparkline(options...))
+	})
+
+	factory.ConnectBind(func(listItem *ListItem) {
+		sparkline := listItemSparkline(listItem)
+		if sparkline == nil {
+			return
+		}
+
+		// The list item stays bound to the item until unbind
+		sparkline.update = func() {
+			sparkline.SetValues(getValues(listItem.GetItem()))
+		}
+		sparkline.update()
+
+		if item := C.gtk_list_item_get_item(listItem.listItem); item != nil {
+			sparkline.item = item
+			C.watchSparklineItem(item, sparkline.widget)
+		}
+	})
+
+	factory.ConnectUnbind(func(listItem *ListItem) {
+		sparkline := listItemSparkline(listItem)
+		if sparkline == nil {
+			return
+		}
+
+		if sparkline.item != nil {
+			C.unwatchSparklineItem(sparkline.item, sparkline.widget)
+			sparkline.item = nil
+		}
+		sparkline.update = nil
+		sparkline.SetValues(nil)
+	})
+
+	factory.ConnectTeardown(func(listItem *ListItem) {
+		sparkline := listItemSparkline(listItem)
+		if sparkline == nil {
+			return
+		}
+
+		// The list item owns the widget, so only forget the Go wrapper and
+		// make sure its finalizer doesn't touch the widget later
+		sparklines.Delete(uintptr(unsafe.Pointer(sparkline.widget)))
+		sparkline.widget = nil
+	})
+
+	return factory
+}
+
+// listItemSparkline returns the sparkline set up as the child of a list item
+// by SparklineFactory, or nil if there is none
+func listItemSparkline(listItem *ListItem) *Sparkline {
+	child := listItem.GetChild()
+	if child == nil {
+		return nil
+	}
+
+	value, ok := sparklines.Load(child.Native())
+	if !ok {
+		return nil
+	}
+	return value.(*Sparkline)
+}
+
+//export sparklineDrawCallback
+func sparklineDrawCallback(area *C.GtkDrawingArea, cr *C.cairo_t, width, height C.int, userData C.gpointer) {
+	value, ok := sparklines.Load(uintptr(unsafe.Pointer(area)))
+	if !ok {
+		return
+	}
+
+	// The Cairo context is only valid for the duration of the draw call
+	value.(*Sparkline).draw(cr, int(width), int(height))
+}
+
+//export sparklineItemNotifyCallback
+func sparklineItemNotifyCallback(item *C.GObject, pspec *C.GParamSpec, userData C.gpointer) {
+	value, ok := sparklines.Load(uintptr(userData))
+	if !ok {
+		return
+	}
+
+	if update := value.(*Sparkline).update; update != nil {
+		uithread.Protect(update)
+	}
+}
@@ -0,0 +1,135 @@
+// Package gtk4 provides spinner functionality for GTK4
+// File: gtk4go/gtk4/spinner.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"context"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/justyntemme/gtk4go"
+)
+
+// SpinnerOption is a function that configures a spinner
+type SpinnerOption func(*Spinner)
+
+// Spinner represents a GTK spinner that shows indeterminate progress
+type Spinner struct {
+	BaseWidget
+	activeTasks atomic.Int32
+}
+
+// NewSpinner creates a new GTK spinner
+func NewSpinner(options ...SpinnerOption) *Spinner {
+	spinner := &Spinner{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_spinner_new(),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(spinner)
+	}
+
+	SetupFinalization(spinner, spinner.Destroy)
+	return spinner
+}
+
+// WithSpinning sets whether the spinner is initially spinning
+func WithSpinning(spinning bool) SpinnerOption {
+	return func(s *Spinner) {
+		s.SetSpinning(spinning)
+	}
+}
+
+// Start starts the spinner animation.
+// It is safe to call from any goroutine; the change is applied on the UI thread.
+func (s *Spinner) Start() {
+	s.SetSpinning(true)
+}
+
+// Stop stops the spinner animation.
+// It is safe to call from any goroutine; the change is applied on the UI thread.
+func (s *Spinner) Stop() {
+	s.SetSpinning(false)
+}
+
+// SetSpinning sets whether the spinner is spinning.
+// It is safe to call from any goroutine; the change is applied on the UI thread.
+func (s *Spinner) SetSpinning(spinning bool) {
+	RunOnUIThread(func() {
+		if s.widget == nil {
+			return
+		}
+
+		var cSpinning C.gboolean
+		if spinning {
+			cSpinning = C.TRUE
+		} else {
+			cSpinning = C.FALSE
+		}
+		C.gtk_spinner_set_spinning((*C.GtkSpinner)(unsafe.Pointer(s.widget)), cSpinning)
+	})
+}
+
+// GetSpinning gets whether the spinner is spinning
+func (s *Spinner) GetSpinning() bool {
+	return C.gtk_spinner_get_spinning((*C.GtkSpinner)(unsafe.Pointer(s.widget))) == C.TRUE
+}
+
+// QueueTask queues a task on the default background worker and keeps the
+// spinner spinning while it runs. When several tasks are queued through the
+// same spinner, it stops only after the last one has completed.
+func (s *Spinner) QueueTask(
+	id string,
+	task func(ctx context.Context, progress func(percent int, message string)) (interface{}, error),
+	onComplete func(result interface{}, err error),
+	onProgress func(percent int, message string),
+) context.CancelFunc {
+	if s.activeTasks.Add(1) == 1 {
+		s.Start()
+	}
+
+	return gtk4go.QueueBackgroundTask(id, task, func(result interface{}, err error) {
+		if s.activeTasks.Add(-1) == 0 {
+			s.Stop()
+		}
+
+		if onComplete != nil {
+			onComplete(result, err)
+		}
+	}, onProgress)
+}
+
+// RunInBackground runs a simple task in the background and keeps the
+// spinner spinning until it completes
+func (s *Spinner) RunInBackground(
+	task func() (interface{}, error),
+	onComplete func(result interface{}, err error),
+) context.CancelFunc {
+	return s.QueueTask("", func(ctx context.Context, _ func(int, string)) (interface{}, error) {
+		// Check for cancellation
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		return task()
+	}, onComplete, nil)
+}
+
+// Destroy destroys the spinner and cleans up resources
+func (s *Spinner) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(s)
+
+	// Call base destroy method
+	s.BaseWidget.Destroy()
+}
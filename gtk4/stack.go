@@ -8,6 +8,7 @@ package gtk4
 import "C"
 
 import (
+	"runtime"
 	"unsafe"
 )
 
@@ -153,6 +154,49 @@ func (s *Stack) AddTitled(child Widget, name, title string) {
 	})
 }
 
+// AddTitledWithIcon adds a child to the stack with the given name, title and
+// icon name. A StackSwitcher shows the icon instead of the title.
+func (s *Stack) AddTitledWithIcon(child Widget, name, title, iconName string) *StackPage {
+	var page *C.GtkStackPage
+	WithCString(name, func(cName *C.char) {
+		WithCString(title, func(cTitle *C.char) {
+			page = C.gtk_stack_add_titled(
+				(*C.GtkStack)(unsafe.Pointer(s.widget)),
+				child.GetWidget(),
+				cName,
+				cTitle,
+			)
+		})
+	})
+
+	stackPage := wrapStackPage(page)
+	if stackPage != nil {
+		stackPage.SetIconName(iconName)
+	}
+	return stackPage
+}
+
+// GetPage returns the page of a child of the stack, or nil if it isn't a child
+func (s *Stack) GetPage(child Widget) *StackPage {
+	if child == nil {
+		return nil
+	}
+	return wrapStackPage(C.gtk_stack_get_page((*C.GtkStack)(unsafe.Pointer(s.widget)), child.GetWidget()))
+}
+
+// GetPages returns a live list model of the stack's pages, whose items are
+// *StackPage values. The model updates as pages are added and removed.
+func (s *Stack) GetPages() ListModel {
+	pages := &StackPages{
+		BaseListModel: BaseListModel{
+			model: (*C.GListModel)(unsafe.Pointer(C.gtk_stack_get_pages((*C.GtkStack)(unsafe.Pointer(s.widget))))),
+		},
+	}
+
+	runtime.SetFinalizer(pages, (*StackPages).Destroy)
+	return pages
+}
+
 // Remove removes a child from the stack
 func (s *Stack) Remove(child Widget) {
 	C.gtk_stack_remove((*C.GtkStack)(unsafe.Pointer(s.widget)), child.GetWidget())
@@ -194,7 +238,20 @@ func (s *Stack) SetVisibleChildName(name string) {
 	})
 }
 
-// GetVisibleChildName gets the name of the visible child
+// SetVisibleChildFull sets the visible child by name, using the given
+// transition instead of the stack's transition type
+func (s *Stack) SetVisibleChildFull(name string, transition StackTransitionType) {
+	WithCString(name, func(cName *C.char) {
+		C.gtk_stack_set_visible_child_full(
+			(*C.GtkStack)(unsafe.Pointer(s.widget)),
+			cName,
+			C.GtkStackTransitionType(transition),
+		)
+	})
+}
+
+// GetVisibleChildName gets the name of the visible child, or "" if the stack
+// has no pages or the visible child has no name
 func (s *Stack) GetVisibleChildName() string {
 	cName := C.gtk_stack_get_visible_child_name((*C.GtkStack)(unsafe.Pointer(s.widget)))
 	if cName == nil {
@@ -276,3 +333,98 @@ func (s *Stack) SetVHomogeneous(homogeneous bool) {
 func (s *Stack) GetVHomogeneous() bool {
 	return C.gtk_stack_get_vhomogeneous((*C.GtkStack)(unsafe.Pointer(s.widget))) == C.TRUE
 }
+
+// StackPage represents a page of a Stack, holding the properties of one child
+type StackPage struct {
+	page *C.GtkStackPage
+}
+
+// wrapStackPage creates a wrapper holding a reference to a stack page
+func wrapStackPage(page *C.GtkStackPage) *StackPage {
+	if page == nil {
+		return nil
+	}
+
+	C.g_object_ref(C.gpointer(unsafe.Pointer(page)))
+	stackPage := &StackPage{page: page}
+	runtime.SetFinalizer(stackPage, (*StackPage).Destroy)
+	return stackPage
+}
+
+// Native returns the underlying GtkStackPage pointer as uintptr
+func (p *StackPage) Native() uintptr {
+	return uintptr(unsafe.Pointer(p.page))
+}
+
+// GetName returns the name of the page
+func (p *StackPage) GetName() string {
+	cName := C.gtk_stack_page_get_name(p.page)
+	if cName == nil {
+		return ""
+	}
+	return C.GoString(cName)
+}
+
+// GetTitle returns the title of the page
+func (p *StackPage) GetTitle() string {
+	cTitle := C.gtk_stack_page_get_title(p.page)
+	if cTitle == nil {
+		return ""
+	}
+	return C.GoString(cTitle)
+}
+
+// SetTitle sets the title of the page
+func (p *StackPage) SetTitle(title string) {
+	WithCString(title, func(cTitle *C.char) {
+		C.gtk_stack_page_set_title(p.page, cTitle)
+	})
+}
+
+// GetIconName returns the icon name of the page
+func (p *StackPage) GetIconName() string {
+	cIconName := C.gtk_stack_page_get_icon_name(p.page)
+	if cIconName == nil {
+		return ""
+	}
+	return C.GoString(cIconName)
+}
+
+// SetIconName sets the icon name of the page; an empty string removes the icon
+func (p *StackPage) SetIconName(iconName string) {
+	if iconName == "" {
+		C.gtk_stack_page_set_icon_name(p.page, nil)
+		return
+	}
+	WithCString(iconName, func(cIconName *C.char) {
+		C.gtk_stack_page_set_icon_name(p.page, cIconName)
+	})
+}
+
+// Destroy releases the reference to the page
+func (p *StackPage) Destroy() {
+	if p.page != nil {
+		C.g_object_unref(C.gpointer(unsafe.Pointer(p.page)))
+		p.page = nil
+	}
+}
+
+// StackPages is the list model of a Stack's pages
+type StackPages struct {
+	BaseListModel
+}
+
+// GetItem returns the page at the given position as a *StackPage
+func (m *StackPages) GetItem(position int) interface{} {
+	if position < 0 || position >= m.GetNItems() {
+		return nil
+	}
+
+	item := C.g_list_model_get_item(m.model, C.guint(position))
+	if item == nil {
+		return nil
+	}
+	defer C.g_object_unref(item)
+
+	return wrapStackPage((*C.GtkStackPage)(unsafe.Pointer(item)))
+}
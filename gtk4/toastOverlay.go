@@ -0,0 +1,233 @@
+// Package gtk4 provides toast notification functionality for GTK4
+// File: gtk4go/gtk4/toastOverlay.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// #define TOAST_STATE_KEY "gtk4go-toast-state"
+//
+// typedef struct {
+//     char *message;
+//     int timeout;
+// } Toast;
+//
+// typedef struct {
+//     GtkWidget *revealer;
+//     GtkWidget *label;
+//     GQueue *queue;
+//     guint timeoutId;
+//     gboolean showing;
+// } ToastState;
+//
+// static void toastFree(gpointer data) {
+//     Toast *toast = data;
+//     g_free(toast->message);
+//     g_free(toast);
+// }
+//
+// static void toastStateFree(gpointer data) {
+//     ToastState *state = data;
+//     g_queue_free_full(state->queue, toastFree);
+//     g_free(state);
+// }
+//
+// static void toastStopTimer(ToastState *state) {
+//     if (state->timeoutId != 0) {
+//         g_source_remove(state->timeoutId);
+//         state->timeoutId = 0;
+//     }
+// }
+//
+// static gboolean toastTimeout(gpointer user_data);
+//
+// // Show the next queued toast, if no toast is being shown
+// static void toastShowNext(ToastState *state) {
+//     if (state->showing || state->revealer == NULL) {
+//         return;
+//     }
+//     Toast *toast = g_queue_pop_head(state->queue);
+//     if (toast == NULL) {
+//         return;
+//     }
+//
+//     gtk_label_set_text(GTK_LABEL(state->label), toast->message);
+//     gtk_revealer_set_reveal_child(GTK_REVEALER(state->revealer), TRUE);
+//     state->showing = TRUE;
+//     if (toast->timeout > 0) {
+//         state->timeoutId = g_timeout_add_seconds(toast->timeout, toastTimeout, state);
+//     }
+//     toastFree(toast);
+// }
+//
+// // Hide the current toast; the next one is shown once it has slid out
+// static void toastDismiss(ToastState *state) {
+//     toastStopTimer(state);
+//     if (state->revealer != NULL) {
+//         gtk_revealer_set_reveal_child(GTK_REVEALER(state->revealer), FALSE);
+//     }
+// }
+//
+// static gboolean toastTimeout(gpointer user_data) {
+//     ToastState *state = user_data;
+//     state->timeoutId = 0;
+//     toastDismiss(state);
+//     return G_SOURCE_REMOVE;
+// }
+//
+// static void toastChildRevealed(GObject *revealer, GParamSpec *pspec, gpointer user_data) {
+//     ToastState *state = user_data;
+//     if (!gtk_revealer_get_child_revealed(GTK_REVEALER(revealer)) && !gtk_revealer_get_reveal_child(GTK_REVEALER(revealer))) {
+//         state->showing = FALSE;
+//         toastShowNext(state);
+//     }
+// }
+//
+// static void toastCloseClicked(GtkButton *button, gpointer user_data) {
+//     toastDismiss(user_data);
+// }
+//
+// // Stop the timer and drop queued toasts when the overlay is destroyed, e.g.
+// // because its window was closed
+// static void toastOverlayDestroyed(GtkWidget *overlay, gpointer user_data) {
+//     ToastState *state = user_data;
+//     toastStopTimer(state);
+//     g_queue_clear_full(state->queue, toastFree);
+//     state->revealer = NULL;
+//     state->label = NULL;
+// }
+//
+// static ToastState* toastState(GtkWidget *overlay) {
+//     return g_object_get_data(G_OBJECT(overlay), TOAST_STATE_KEY);
+// }
+//
+// // Create an overlay with a revealer at the bottom center that shows toasts
+// static GtkWidget* createToastOverlay() {
+//     GtkWidget *overlay = gtk_overlay_new();
+//
+//     ToastState *state = g_new0(ToastState, 1);
+//     state->queue = g_queue_new();
+//
+//     GtkWidget *box = gtk_box_new(GTK_ORIENTATION_HORIZONTAL, 12);
+//     gtk_widget_add_css_class(box, "toast");
+//     gtk_widget_add_css_class(box, "osd");
+//     gtk_widget_add_css_class(box, "app-notification");
+//
+//     state->label = gtk_label_new(NULL);
+//     gtk_label_set_wrap(GTK_LABEL(state->label), TRUE);
+//     gtk_widget_set_hexpand(state->label, TRUE);
+//     gtk_box_append(GTK_BOX(box), state->label);
+//
+//     GtkWidget *close = gtk_button_new_from_icon_name("window-close-symbolic");
+//     gtk_widget_add_css_class(close, "flat");
+//     gtk_widget_add_css_class(close, "circular");
+//     gtk_widget_set_valign(close, GTK_ALIGN_CENTER);
+//     gtk_widget_set_tooltip_text(close, "Dismiss");
+//     g_signal_connect(close, "clicked", G_CALLBACK(toastCloseClicked), state);
+//     gtk_box_append(GTK_BOX(box), close);
+//
+//     state->revealer = gtk_revealer_new();
+//     gtk_revealer_set_transition_type(GTK_REVEALER(state->revealer), GTK_REVEALER_TRANSITION_TYPE_SLIDE_UP);
+//     gtk_revealer_set_child(GTK_REVEALER(state->revealer), box);
+//     gtk_widget_set_halign(state->revealer, GTK_ALIGN_CENTER);
+//     gtk_widget_set_valign(state->revealer, GTK_ALIGN_END);
+//     gtk_widget_set_margin_bottom(state->revealer, 12);
+//     g_signal_connect(state->revealer, "notify::child-revealed", G_CALLBACK(toastChildRevealed), state);
+//     gtk_overlay_add_overlay(GTK_OVERLAY(overlay), state->revealer);
+//
+//     g_object_set_data_full(G_OBJECT(overlay), TOAST_STATE_KEY, state, toastStateFree);
+//     g_signal_connect(overlay, "destroy", G_CALLBACK(toastOverlayDestroyed), state);
+//     return overlay;
+// }
+//
+// static void toastOverlayAdd(GtkWidget *overlay, const char *message, int timeout) {
+//     ToastState *state = toastState(overlay);
+//     if (state->revealer == NULL) {
+//         return;
+//     }
+//     Toast *toast = g_new(Toast, 1);
+//     toast->message = g_strdup(message);
+//     toast->timeout = timeout;
+//     g_queue_push_tail(state->queue, toast);
+//     toastShowNext(state);
+// }
+//
+// static void toastOverlayDismiss(GtkWidget *overlay) {
+//     toastDismiss(toastState(overlay));
+// }
+//
+// static void toastOverlayClear(GtkWidget *overlay) {
+//     ToastState *state = toastState(overlay);
+//     g_queue_clear_full(state->queue, toastFree);
+//     toastDismiss(state);
+// }
+//
+// static guint toastOverlayQueued(GtkWidget *overlay) {
+//     return g_queue_get_length(toastState(overlay)->queue);
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+// ToastOverlay represents an overlay that shows toasts: short messages that
+// float at the bottom of its child and disappear on their own, for feedback
+// that doesn't need a dialog. Toasts are shown one at a time in the order
+// they were added; each one slides in, stays for its timeout or until its
+// close button is clicked, and slides out before the next one is shown.
+type ToastOverlay struct {
+	Overlay
+}
+
+// NewToastOverlay creates a new toast overlay. Set the main child with
+// SetChild or the WithOverlayChild option.
+func NewToastOverlay(options ...OverlayOption) *ToastOverlay {
+	toastOverlay := &ToastOverlay{
+		Overlay: Overlay{
+			BaseWidget: BaseWidget{
+				widget: C.createToastOverlay(),
+			},
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(&toastOverlay.Overlay)
+	}
+
+	SetupFinalization(toastOverlay, toastOverlay.Destroy)
+	return toastOverlay
+}
+
+// AddToast queues a toast with the given message. It is dismissed after
+// timeoutSeconds; a timeout of 0 or less keeps it until its close button is
+// clicked or DismissToast is called. Timers and queued toasts are dropped
+// when the overlay is destroyed, e.g. with its window.
+func (t *ToastOverlay) AddToast(message string, timeoutSeconds int) {
+	cMessage := C.CString(message)
+	defer C.free(unsafe.Pointer(cMessage))
+	C.toastOverlayAdd(t.widget, cMessage, C.int(timeoutSeconds))
+}
+
+// DismissToast dismisses the toast being shown, after which the next queued
+// toast is shown
+func (t *ToastOverlay) DismissToast() {
+	C.toastOverlayDismiss(t.widget)
+}
+
+// ClearToasts dismisses the toast being shown and drops all queued toasts
+func (t *ToastOverlay) ClearToasts() {
+	C.toastOverlayClear(t.widget)
+}
+
+// GetQueuedToasts returns the number of toasts waiting to be shown
+func (t *ToastOverlay) GetQueuedToasts() int {
+	return int(C.toastOverlayQueued(t.widget))
+}
+
+// Destroy destroys the toast overlay and cleans up resources
+func (t *ToastOverlay) Destroy() {
+	t.Overlay.Destroy()
+}
@@ -0,0 +1,224 @@
+// Package gtk4 provides toggle button functionality for GTK4
+// File: gtk4go/gtk4/toggleButton.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// Define signal type for toggle buttons
+const (
+	SignalToggled SignalType = "toggled"
+)
+
+// ToggleButtonOption is a function that configures a toggle button
+type ToggleButtonOption func(*ToggleButton)
+
+// ToggleButton represents a GTK toggle button
+type ToggleButton struct {
+	BaseWidget
+}
+
+// NewToggleButton creates a new GTK toggle button with the given label
+func NewToggleButton(label string, options ...ToggleButtonOption) *ToggleButton {
+	var widget *C.GtkWidget
+
+	WithCString(label, func(cLabel *C.char) {
+		widget = C.gtk_toggle_button_new_with_label(cLabel)
+	})
+
+	toggleButton := &ToggleButton{
+		BaseWidget: BaseWidget{
+			widget: widget,
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(toggleButton)
+	}
+
+	SetupFinalization(toggleButton, toggleButton.Destroy)
+	return toggleButton
+}
+
+// WithActive sets the initial active state of the toggle button
+func WithActive(active bool) ToggleButtonOption {
+	return func(tb *ToggleButton) {
+		tb.SetActive(active)
+	}
+}
+
+// WithToggleGroup adds the toggle button to the group of another toggle button
+func WithToggleGroup(group *ToggleButton) ToggleButtonOption {
+	return func(tb *ToggleButton) {
+		tb.SetGroup(group)
+	}
+}
+
+// SetActive sets whether the toggle button is pressed in.
+// This emits the "toggled" signal if the state changes.
+func (tb *ToggleButton) SetActive(active bool) {
+	var cActive C.gboolean
+	if active {
+		cActive = C.TRUE
+	} else {
+		cActive = C.FALSE
+	}
+	C.gtk_toggle_button_set_active((*C.GtkToggleButton)(unsafe.Pointer(tb.widget)), cActive)
+}
+
+// GetActive gets whether the toggle button is pressed in
+func (tb *ToggleButton) GetActive() bool {
+	return C.gtk_toggle_button_get_active((*C.GtkToggleButton)(unsafe.Pointer(tb.widget))) == C.TRUE
+}
+
+// SetLabel sets the toggle button's label
+func (tb *ToggleButton) SetLabel(label string) {
+	WithCString(label, func(cLabel *C.char) {
+		C.gtk_button_set_label((*C.GtkButton)(unsafe.Pointer(tb.widget)), cLabel)
+	})
+}
+
+// GetLabel gets the toggle button's label
+func (tb *ToggleButton) GetLabel() string {
+	cLabel := C.gtk_button_get_label((*C.GtkButton)(unsafe.Pointer(tb.widget)))
+	if cLabel == nil {
+		return ""
+	}
+	return C.GoString(cLabel)
+}
+
+// SetGroup adds the toggle button to the group of another toggle button.
+// Only one button in a group can be active at a time; activating one
+// deactivates the others. Passing nil removes the button from its group.
+func (tb *ToggleButton) SetGroup(group *ToggleButton) {
+	if group == nil {
+		C.gtk_toggle_button_set_group((*C.GtkToggleButton)(unsafe.Pointer(tb.widget)), nil)
+		return
+	}
+	C.gtk_toggle_button_set_group(
+		(*C.GtkToggleButton)(unsafe.Pointer(tb.widget)),
+		(*C.GtkToggleButton)(unsafe.Pointer(group.widget)),
+	)
+}
+
+// ConnectToggled connects a callback to the toggle button's "toggled" signal.
+// In a group, the signal is emitted both for the button that becomes inactive
+// and for the one that becomes active, so callbacks should check GetActive.
+func (tb *ToggleButton) ConnectToggled(callback func()) uint64 {
+	return Connect(tb, SignalToggled, callback)
+}
+
+// DisconnectToggled disconnects all toggled signal handlers
+func (tb *ToggleButton) DisconnectToggled() {
+	callbackIDs := getCallbackIDsForSignal(uintptr(unsafe.Pointer(tb.widget)), SignalToggled)
+
+	// Disconnect each callback
+	for _, id := range callbackIDs {
+		Disconnect(id)
+	}
+}
+
+// Destroy destroys the toggle button and cleans up resources
+func (tb *ToggleButton) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(tb)
+
+	// Call base destroy method
+	tb.BaseWidget.Destroy()
+}
+
+// ToggleGroup is a set of grouped toggle buttons, such as a segmented
+// control, where exactly one button is active
+type ToggleGroup struct {
+	box     *Box
+	buttons []*ToggleButton
+}
+
+// NewToggleGroup creates a linked group of toggle buttons with the given labels.
+// The first button is active initially.
+func NewToggleGroup(orientation Orientation, labels ...string) *ToggleGroup {
+	group := &ToggleGroup{
+		box: NewBox(orientation, 0),
+	}
+	group.box.AddCssClass("linked")
+
+	for i, label := range labels {
+		button := NewToggleButton(label)
+		if i > 0 {
+			button.SetGroup(group.buttons[0])
+		}
+		group.box.Append(button)
+		group.buttons = append(group.buttons, button)
+	}
+
+	// Ensure exactly one member is active
+	if len(group.buttons) > 0 {
+		group.buttons[0].SetActive(true)
+	}
+
+	return group
+}
+
+// GetBox returns the box containing the linked buttons
+func (g *ToggleGroup) GetBox() *Box {
+	return g.box
+}
+
+// GetButtons returns the buttons in the group
+func (g *ToggleGroup) GetButtons() []*ToggleButton {
+	return g.buttons
+}
+
+// GetActiveIndex returns the index of the active button, or -1 if none is active
+func (g *ToggleGroup) GetActiveIndex() int {
+	for i, button := range g.buttons {
+		if button.GetActive() {
+			return i
+		}
+	}
+	return -1
+}
+
+// SetActiveIndex activates the button at the given index.
+// Indices out of range are ignored.
+func (g *ToggleGroup) SetActiveIndex(index int) {
+	if index < 0 || index >= len(g.buttons) {
+		return
+	}
+	g.buttons[index].SetActive(true)
+}
+
+// ConnectChanged connects a callback that is called with the index of the
+// newly active button. It is called once per change, not once per button.
+func (g *ToggleGroup) ConnectChanged(callback func(index int)) {
+	for i, button := range g.buttons {
+		index := i
+		b := button
+		b.ConnectToggled(func() {
+			// Only report the button that became active
+			if b.GetActive() {
+				callback(index)
+			}
+		})
+	}
+}
+
+// LinkWidgets places widgets in a box styled with the "linked" CSS class,
+// so that they are drawn as a single segmented control
+func LinkWidgets(orientation Orientation, widgets ...Widget) *Box {
+	box := NewBox(orientation, 0)
+	box.AddCssClass("linked")
+
+	for _, widget := range widgets {
+		box.Append(widget)
+	}
+
+	return box
+}
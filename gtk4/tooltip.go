@@ -81,22 +81,53 @@ type Tooltip struct {
 	tooltip *C.GtkTooltip
 }
 
-// SetText sets the tooltip text
+// SetText sets the tooltip text. An empty string removes the text.
 func (t *Tooltip) SetText(text string) {
+	if text == "" {
+		C.gtk_tooltip_set_text(t.tooltip, nil)
+		return
+	}
+
 	cText := C.CString(text)
 	defer C.free(unsafe.Pointer(cText))
 	C.gtk_tooltip_set_text(t.tooltip, cText)
 }
 
-// SetMarkup sets the tooltip markup
+// SetMarkup sets the tooltip text using Pango markup. An empty string removes the text.
 func (t *Tooltip) SetMarkup(markup string) {
+	if markup == "" {
+		C.gtk_tooltip_set_markup(t.tooltip, nil)
+		return
+	}
+
 	cMarkup := C.CString(markup)
 	defer C.free(unsafe.Pointer(cMarkup))
 	C.gtk_tooltip_set_markup(t.tooltip, cMarkup)
 }
 
-// SetIcon sets the tooltip icon
-func (t *Tooltip) SetIcon(iconName string) {
+// SetIcon shows the contents of an image next to the tooltip text.
+// Passing nil removes the icon.
+func (t *Tooltip) SetIcon(image *Image) {
+	if image == nil || image.widget == nil {
+		C.setTooltipIcon(t.tooltip, nil)
+		return
+	}
+
+	gtkImage := (*C.GtkImage)(unsafe.Pointer(image.widget))
+	switch C.gtk_image_get_storage_type(gtkImage) {
+	case C.GTK_IMAGE_ICON_NAME:
+		C.gtk_tooltip_set_icon_from_icon_name(t.tooltip, C.gtk_image_get_icon_name(gtkImage))
+	case C.GTK_IMAGE_GICON:
+		C.gtk_tooltip_set_icon_from_gicon(t.tooltip, C.gtk_image_get_gicon(gtkImage))
+	case C.GTK_IMAGE_PAINTABLE:
+		C.setTooltipIcon(t.tooltip, C.gtk_image_get_paintable(gtkImage))
+	default:
+		C.setTooltipIcon(t.tooltip, nil)
+	}
+}
+
+// SetIconName shows a named icon from the icon theme next to the tooltip text
+func (t *Tooltip) SetIconName(iconName string) {
 	cIconName := C.CString(iconName)
 	defer C.free(unsafe.Pointer(cIconName))
 	
@@ -104,6 +135,16 @@ func (t *Tooltip) SetIcon(iconName string) {
 	C.gtk_tooltip_set_icon_from_icon_name(t.tooltip, cIconName)
 }
 
+// SetCustom replaces the tooltip contents with a custom widget, for tooltips
+// that need more than text and an icon. Passing nil restores the default contents.
+func (t *Tooltip) SetCustom(widget Widget) {
+	if widget == nil {
+		C.gtk_tooltip_set_custom(t.tooltip, nil)
+		return
+	}
+	C.gtk_tooltip_set_custom(t.tooltip, widget.GetWidget())
+}
+
 // SetTipArea sets the area of the widget associated with this tooltip
 func (t *Tooltip) SetTipArea(x, y, width, height int) {
 	var rect C.GdkRectangle
@@ -177,7 +218,9 @@ func (w *BaseWidget) GetTooltipDelay() uint {
 	return uint(C.getTooltipDelay(w.widget))
 }
 
-// ConnectQueryTooltip connects a callback for the query-tooltip signal
+// ConnectQueryTooltip connects a callback for the query-tooltip signal.
+// The callback fills in the tooltip with the Tooltip setters and returns true
+// to show it, or returns false to show no tooltip at this position.
 func (w *BaseWidget) ConnectQueryTooltip(callback TooltipQueryCallback) uint64 {
 	if callback == nil {
 		return 0
@@ -0,0 +1,68 @@
+// Package gtk4 provides GVariant conversion for GTK4
+// File: gtk4go/gtk4/variant.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// variantFromValue converts a Go value to a new floating GVariant.
+// Supported values are bool, string, int and int32 (stored as 32-bit
+// integers), int64, uint32 and float64.
+func variantFromValue(goValue interface{}) (*C.GVariant, error) {
+	switch v := goValue.(type) {
+	case bool:
+		return C.g_variant_new_boolean(boolToGBoolean(v)), nil
+	case string:
+		cStr := C.CString(v)
+		defer C.free(unsafe.Pointer(cStr))
+		return C.g_variant_new_string(cStr), nil
+	case int:
+		if int(int32(v)) != v {
+			return nil, &GTKError{Op: "VariantFromValue", Err: fmt.Errorf("int %d out of 32-bit range", v)}
+		}
+		return C.g_variant_new_int32(C.gint32(v)), nil
+	case int32:
+		return C.g_variant_new_int32(C.gint32(v)), nil
+	case int64:
+		return C.g_variant_new_int64(C.gint64(v)), nil
+	case uint32:
+		return C.g_variant_new_uint32(C.guint32(v)), nil
+	case float64:
+		return C.g_variant_new_double(C.gdouble(v)), nil
+	}
+
+	return nil, &GTKError{Op: "VariantFromValue", Err: fmt.Errorf("unsupported type %T", goValue)}
+}
+
+// valueFromVariant converts a GVariant to the corresponding Go value, the
+// inverse of variantFromValue: 32-bit integers are returned as int.
+// Unsupported types return nil.
+func valueFromVariant(variant *C.GVariant) interface{} {
+	if variant == nil {
+		return nil
+	}
+
+	switch C.GoString(C.g_variant_get_type_string(variant)) {
+	case "b":
+		return C.g_variant_get_boolean(variant) == C.TRUE
+	case "s":
+		return C.GoString(C.g_variant_get_string(variant, nil))
+	case "i":
+		return int(C.g_variant_get_int32(variant))
+	case "x":
+		return int64(C.g_variant_get_int64(variant))
+	case "u":
+		return uint32(C.g_variant_get_uint32(variant))
+	case "d":
+		return float64(C.g_variant_get_double(variant))
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+// Package gtk4 provides per-widget Go data storage for GTK4
+// File: gtk4go/gtk4/widgetData.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// extern void widgetDataWeakNotify(gpointer data, GObject *object);
+// extern void widgetWrapperWeakNotify(gpointer data, GObject *object);
+//
+// // Get notified when the widget is finalized so its data can be released
+// static void watchWidgetData(GtkWidget *widget) {
+//     g_object_weak_ref(G_OBJECT(widget), (GWeakNotify)widgetDataWeakNotify, NULL);
+// }
+//
+// static void unwatchWidgetData(GtkWidget *widget) {
+//     g_object_weak_unref(G_OBJECT(widget), (GWeakNotify)widgetDataWeakNotify, NULL);
+// }
+//
+// // Get notified when the widget is finalized so its wrappers can be invalidated
+// static void watchWidgetWrappers(GtkWidget *widget) {
+//     g_object_weak_ref(G_OBJECT(widget), (GWeakNotify)widgetWrapperWeakNotify, NULL);
+// }
+import "C"
+
+import (
+	"sync"
+	"unsafe"
+	"weak"
+)
+
+// widgetDataRegistry holds Go values associated with widgets, keyed by the
+// widget pointer and then by key
+var widgetDataRegistry = struct {
+	sync.RWMutex
+	data map[uintptr]map[string]interface{}
+}{
+	data: make(map[uintptr]map[string]interface{}),
+}
+
+// SetData associates a Go value with the widget under the given key,
+// replacing any previous value. Setting nil removes the key. The data is
+// released when the widget is destroyed or finalized.
+func (w *BaseWidget) SetData(key string, value interface{}) {
+	if w.widget == nil {
+		return
+	}
+
+	widgetPtr := uintptr(unsafe.Pointer(w.widget))
+
+	widgetDataRegistry.Lock()
+	defer widgetDataRegistry.Unlock()
+
+	values, ok := widgetDataRegistry.data[widgetPtr]
+	if value == nil {
+		if ok {
+			delete(values, key)
+		}
+		return
+	}
+
+	if !ok {
+		// The first value for this widget; watch for finalization so the
+		// entry can't outlive the widget or be inherited by a new widget
+		// allocated at the same address
+		values = make(map[string]interface{})
+		widgetDataRegistry.data[widgetPtr] = values
+		C.watchWidgetData(w.widget)
+	}
+	values[key] = value
+}
+
+// GetData returns the Go value associated with the widget under the given
+// key, or nil if there is none
+func (w *BaseWidget) GetData(key string) interface{} {
+	if w.widget == nil {
+		return nil
+	}
+
+	widgetDataRegistry.RLock()
+	defer widgetDataRegistry.RUnlock()
+
+	if values, ok := widgetDataRegistry.data[uintptr(unsafe.Pointer(w.widget))]; ok {
+		return values[key]
+	}
+	return nil
+}
+
+// clearWidgetData releases all Go values associated with a widget
+func clearWidgetData(widget *C.GtkWidget) {
+	if widget == nil {
+		return
+	}
+
+	widgetPtr := uintptr(unsafe.Pointer(widget))
+
+	widgetDataRegistry.Lock()
+	defer widgetDataRegistry.Unlock()
+
+	if _, ok := widgetDataRegistry.data[widgetPtr]; ok {
+		delete(widgetDataRegistry.data, widgetPtr)
+		C.unwatchWidgetData(widget)
+	}
+}
+
+//export widgetDataWeakNotify
+func widgetDataWeakNotify(data C.gpointer, object *C.GObject) {
+	widgetDataRegistry.Lock()
+	defer widgetDataRegistry.Unlock()
+
+	delete(widgetDataRegistry.data, uintptr(unsafe.Pointer(object)))
+}
+
+// widgetWrappers holds weak pointers to the Go wrappers of each widget, keyed
+// by the widget pointer. GTK may free a widget while wrappers still point to
+// it (e.g. when it is removed from its parent), so the wrappers are
+// invalidated on finalization; their Destroy and finalizer become no-ops
+// instead of touching freed memory.
+var widgetWrappers = struct {
+	sync.Mutex
+	wrappers map[uintptr][]weak.Pointer[BaseWidget]
+}{
+	wrappers: make(map[uintptr][]weak.Pointer[BaseWidget]),
+}
+
+// trackWrapper registers a wrapper so it is invalidated when its widget is
+// finalized
+func trackWrapper(w *BaseWidget) {
+	if w == nil || w.widget == nil {
+		return
+	}
+
+	widgetPtr := uintptr(unsafe.Pointer(w.widget))
+
+	widgetWrappers.Lock()
+	defer widgetWrappers.Unlock()
+
+	wrappers, ok := widgetWrappers.wrappers[widgetPtr]
+	if !ok {
+		C.watchWidgetWrappers(w.widget)
+	}
+
+	// Drop the wrappers that have been garbage collected meanwhile
+	live := wrappers[:0]
+	for _, wrapper := range wrappers {
+		if wrapper.Value() != nil {
+			live = append(live, wrapper)
+		}
+	}
+	widgetWrappers.wrappers[widgetPtr] = append(live, weak.Make(w))
+}
+
+//export widgetWrapperWeakNotify
+func widgetWrapperWeakNotify(data C.gpointer, object *C.GObject) {
+	widgetPtr := uintptr(unsafe.Pointer(object))
+
+	widgetWrappers.Lock()
+	wrappers := widgetWrappers.wrappers[widgetPtr]
+	delete(widgetWrappers.wrappers, widgetPtr)
+	widgetWrappers.Unlock()
+
+	for _, wrapper := range wrappers {
+		if w := wrapper.Value(); w != nil && uintptr(unsafe.Pointer(w.widget)) == widgetPtr {
+			w.widget = nil
+		}
+	}
+}
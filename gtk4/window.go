@@ -56,6 +56,18 @@ package gtk4
 // static void setWindowTitlebar(GtkWindow *window, GtkWidget *titlebar) {
 //     gtk_window_set_titlebar(window, titlebar);
 // }
+//
+// // Set the margins of the window's child, if it has one
+// static void setChildMargins(GtkWindow *window, int top, int right, int bottom, int left) {
+//     GtkWidget* child = gtk_window_get_child(window);
+//     if (child == NULL) {
+//         return;
+//     }
+//     gtk_widget_set_margin_top(child, top);
+//     gtk_widget_set_margin_end(child, right);
+//     gtk_widget_set_margin_bottom(child, bottom);
+//     gtk_widget_set_margin_start(child, left);
+// }
 import "C"
 
 import (
@@ -69,6 +81,7 @@ type WindowOption func(*Window)
 type Window struct {
 	BaseWidget
 	isAcceleratedRendering bool
+	contentMargins         *[4]int // top, right, bottom, left; nil if not set
 }
 
 // NewWindow creates a new GTK window with the given title
@@ -151,10 +164,7 @@ func WithDefaultSize(width, height int) WindowOption {
 func WithTransientFor(parent *Window) WindowOption {
 	return func(w *Window) {
 		if parent != nil {
-			C.gtk_window_set_transient_for(
-				(*C.GtkWindow)(unsafe.Pointer(w.widget)),
-				(*C.GtkWindow)(unsafe.Pointer(parent.widget)),
-			)
+			w.SetTransientFor(parent)
 		}
 	}
 }
@@ -162,14 +172,35 @@ func WithTransientFor(parent *Window) WindowOption {
 // WithModal sets whether the window is modal
 func WithModal(modal bool) WindowOption {
 	return func(w *Window) {
-		var cmodal C.gboolean
-		if modal {
-			cmodal = C.TRUE
-		} else {
-			cmodal = C.FALSE
-		}
-		C.gtk_window_set_modal((*C.GtkWindow)(unsafe.Pointer(w.widget)), cmodal)
+		w.SetModal(modal)
+	}
+}
+
+// SetTransientFor sets the parent window, which the window is kept above and
+// centered on. Set it before showing the window; nil removes the parent.
+func (w *Window) SetTransientFor(parent *Window) {
+	var cParent *C.GtkWindow
+	if parent != nil {
+		cParent = (*C.GtkWindow)(unsafe.Pointer(parent.widget))
+	}
+	C.gtk_window_set_transient_for((*C.GtkWindow)(unsafe.Pointer(w.widget)), cParent)
+}
+
+// SetModal sets whether the window is modal, blocking input to other windows
+// of the application while it is shown
+func (w *Window) SetModal(modal bool) {
+	var cmodal C.gboolean
+	if modal {
+		cmodal = C.TRUE
+	} else {
+		cmodal = C.FALSE
 	}
+	C.gtk_window_set_modal((*C.GtkWindow)(unsafe.Pointer(w.widget)), cmodal)
+}
+
+// GetModal returns whether the window is modal
+func (w *Window) GetModal() bool {
+	return C.gtk_window_get_modal((*C.GtkWindow)(unsafe.Pointer(w.widget))) == C.TRUE
 }
 
 // WithAcceleratedRendering sets whether to use hardware acceleration
@@ -196,12 +227,92 @@ func (w *Window) SetDefaultSize(width, height int) {
 	C.gtk_window_set_default_size((*C.GtkWindow)(unsafe.Pointer(w.widget)), C.int(width), C.int(height))
 }
 
+// GetDefaultSize returns the default window size. Once the window is shown,
+// this is the size it has when it isn't maximized or fullscreen.
+func (w *Window) GetDefaultSize() (width, height int) {
+	var cWidth, cHeight C.int
+	C.gtk_window_get_default_size((*C.GtkWindow)(unsafe.Pointer(w.widget)), &cWidth, &cHeight)
+	return int(cWidth), int(cHeight)
+}
+
+// SetMaximized maximizes or unmaximizes the window. It can be called before
+// the window is shown.
+func (w *Window) SetMaximized(maximized bool) {
+	if maximized {
+		C.gtk_window_maximize((*C.GtkWindow)(unsafe.Pointer(w.widget)))
+	} else {
+		C.gtk_window_unmaximize((*C.GtkWindow)(unsafe.Pointer(w.widget)))
+	}
+}
+
+// IsMaximized returns whether the window is maximized
+func (w *Window) IsMaximized() bool {
+	return C.gtk_window_is_maximized((*C.GtkWindow)(unsafe.Pointer(w.widget))) == C.TRUE
+}
+
+// SetIconName sets the name of the themed icon used for the window, e.g. in
+// the taskbar. If the icon theme has no such icon, no icon is shown; an empty
+// name unsets the icon so the default icon name is used.
+func (w *Window) SetIconName(iconName string) {
+	if iconName == "" {
+		C.gtk_window_set_icon_name((*C.GtkWindow)(unsafe.Pointer(w.widget)), nil)
+		return
+	}
+	WithCString(iconName, func(cIconName *C.char) {
+		C.gtk_window_set_icon_name((*C.GtkWindow)(unsafe.Pointer(w.widget)), cIconName)
+	})
+}
+
+// GetIconName returns the name of the window's icon, or an empty string
+func (w *Window) GetIconName() string {
+	cIconName := C.gtk_window_get_icon_name((*C.GtkWindow)(unsafe.Pointer(w.widget)))
+	if cIconName == nil {
+		return ""
+	}
+	return C.GoString(cIconName)
+}
+
+// WithIconName sets the name of the themed icon used for the window
+func WithIconName(iconName string) WindowOption {
+	return func(w *Window) {
+		w.SetIconName(iconName)
+	}
+}
+
 // SetChild sets the child widget for the window
 func (w *Window) SetChild(child Widget) {
 	C.gtk_window_set_child((*C.GtkWindow)(unsafe.Pointer(w.widget)), child.GetWidget())
 
 	// Re-apply optimization for resizing when child changes
 	C.setContentSizing((*C.GtkWindow)(unsafe.Pointer(w.widget)))
+
+	// Content margins apply to whichever child the window has
+	w.applyContentMargins()
+}
+
+// SetContentMargins sets the space around the window's content, in CSS order.
+// The margins are applied to the window's child and kept when the child is
+// replaced with SetChild, so they can be set before the window has a child.
+func (w *Window) SetContentMargins(top, right, bottom, left int) {
+	w.contentMargins = &[4]int{top, right, bottom, left}
+	w.applyContentMargins()
+}
+
+// WithContentMargins sets the space around the window's content at creation time
+func WithContentMargins(top, right, bottom, left int) WindowOption {
+	return func(w *Window) {
+		w.SetContentMargins(top, right, bottom, left)
+	}
+}
+
+// applyContentMargins applies the content margins to the current child, if any
+func (w *Window) applyContentMargins() {
+	if w.contentMargins == nil {
+		return
+	}
+
+	m := w.contentMargins
+	C.setChildMargins((*C.GtkWindow)(unsafe.Pointer(w.widget)), C.int(m[0]), C.int(m[1]), C.int(m[2]), C.int(m[3]))
 }
 
 // Show makes the window visible
@@ -211,10 +322,13 @@ func (w *Window) Show() {
 	C.gtk_widget_set_visible(w.widget, C.TRUE)
 }
 
-// Present presents the window to the user (preferred in GTK4)
+// Present presents the window to the user (preferred in GTK4). It shows a
+// hidden window, restores a minimized one and raises it above other windows,
+// e.g. to bring an existing window forward on a second launch.
 func (w *Window) Present() {
 	// Ensure hardware acceleration is set up before presenting
 	w.EnableAcceleratedRendering()
+	C.gtk_window_unminimize((*C.GtkWindow)(unsafe.Pointer(w.widget)))
 	C.gtk_window_present((*C.GtkWindow)(unsafe.Pointer(w.widget)))
 }
 
@@ -0,0 +1,102 @@
+// Package gtk4 provides window controls functionality for GTK4
+// File: gtk4go/gtk4/windowControls.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// PackType defines which side of a container a widget is packed on
+type PackType int
+
+const (
+	// PackTypeStart packs the widget at the start of the container
+	PackTypeStart PackType = C.GTK_PACK_START
+	// PackTypeEnd packs the widget at the end of the container
+	PackTypeEnd PackType = C.GTK_PACK_END
+)
+
+// WindowControlsOption is a function that configures window controls
+type WindowControlsOption func(*WindowControls)
+
+// WindowControls represents a GTK window controls widget that shows the
+// native minimize, maximize and close buttons for custom title bars
+type WindowControls struct {
+	BaseWidget
+}
+
+// NewWindowControls creates new window controls for the given side of the decoration layout
+func NewWindowControls(side PackType, options ...WindowControlsOption) *WindowControls {
+	controls := &WindowControls{
+		BaseWidget: BaseWidget{
+			widget: C.gtk_window_controls_new(C.GtkPackType(side)),
+		},
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(controls)
+	}
+
+	SetupFinalization(controls, controls.Destroy)
+	return controls
+}
+
+// WithControlsDecorationLayout sets the decoration layout for the window controls
+func WithControlsDecorationLayout(layout string) WindowControlsOption {
+	return func(wc *WindowControls) {
+		wc.SetDecorationLayout(layout)
+	}
+}
+
+// SetDecorationLayout sets the decoration layout for the window controls.
+// The format is the same as for HeaderBar, e.g. "icon:minimize,maximize,close".
+// An empty string resets the layout to the one from GtkSettings.
+func (wc *WindowControls) SetDecorationLayout(layout string) {
+	if layout == "" {
+		C.gtk_window_controls_set_decoration_layout((*C.GtkWindowControls)(unsafe.Pointer(wc.widget)), nil)
+		return
+	}
+
+	WithCString(layout, func(cLayout *C.char) {
+		C.gtk_window_controls_set_decoration_layout((*C.GtkWindowControls)(unsafe.Pointer(wc.widget)), cLayout)
+	})
+}
+
+// GetDecorationLayout gets the decoration layout of the window controls
+func (wc *WindowControls) GetDecorationLayout() string {
+	cLayout := C.gtk_window_controls_get_decoration_layout((*C.GtkWindowControls)(unsafe.Pointer(wc.widget)))
+	if cLayout == nil {
+		return ""
+	}
+	return C.GoString(cLayout)
+}
+
+// SetSide sets which side of the decoration layout the controls represent
+func (wc *WindowControls) SetSide(side PackType) {
+	C.gtk_window_controls_set_side((*C.GtkWindowControls)(unsafe.Pointer(wc.widget)), C.GtkPackType(side))
+}
+
+// GetSide gets which side of the decoration layout the controls represent
+func (wc *WindowControls) GetSide() PackType {
+	return PackType(C.gtk_window_controls_get_side((*C.GtkWindowControls)(unsafe.Pointer(wc.widget))))
+}
+
+// GetEmpty returns whether the window controls have no buttons to show
+func (wc *WindowControls) GetEmpty() bool {
+	return C.gtk_window_controls_get_empty((*C.GtkWindowControls)(unsafe.Pointer(wc.widget))) == C.TRUE
+}
+
+// Destroy destroys the window controls and cleans up resources
+func (wc *WindowControls) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(wc)
+
+	// Call base destroy method
+	wc.BaseWidget.Destroy()
+}
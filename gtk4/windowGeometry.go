@@ -0,0 +1,140 @@
+// Package gtk4 provides window geometry persistence for GTK4
+// File: gtk4go/gtk4/windowGeometry.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+import "C"
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// windowGeometry is the saved state of a window
+type windowGeometry struct {
+	Width     int  `json:"width"`
+	Height    int  `json:"height"`
+	Maximized bool `json:"maximized"`
+}
+
+// windowGeometryMutex serializes access to the geometry file
+var windowGeometryMutex sync.Mutex
+
+// windowGeometryPath returns the path of the geometry file of the program,
+// e.g. ~/.config/<program>/window-geometry.json on Linux
+func windowGeometryPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	program := ""
+	if prgname := C.g_get_prgname(); prgname != nil {
+		program = C.GoString(prgname)
+	}
+	if program == "" {
+		program = filepath.Base(os.Args[0])
+	}
+
+	return filepath.Join(configDir, program, "window-geometry.json"), nil
+}
+
+// loadWindowGeometries reads all saved geometries. A missing or corrupt
+// file yields an empty map.
+func loadWindowGeometries(path string) map[string]windowGeometry {
+	geometries := make(map[string]windowGeometry)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			DebugLog(DebugLevelWarning, DebugComponentGeneral, "Can't read window geometry: %v", err)
+		}
+		return geometries
+	}
+
+	if err := json.Unmarshal(data, &geometries); err != nil {
+		DebugLog(DebugLevelWarning, DebugComponentGeneral, "Ignoring corrupt window geometry file %s: %v", path, err)
+		return make(map[string]windowGeometry)
+	}
+	return geometries
+}
+
+// SaveGeometry saves the size and maximized state of the window under the
+// given key, in a JSON file in the user's config directory. The size saved
+// for a maximized window is its unmaximized size.
+func (w *Window) SaveGeometry(key string) error {
+	width, height := w.GetDefaultSize()
+	geometry := windowGeometry{
+		Width:     width,
+		Height:    height,
+		Maximized: w.IsMaximized(),
+	}
+
+	windowGeometryMutex.Lock()
+	defer windowGeometryMutex.Unlock()
+
+	path, err := windowGeometryPath()
+	if err != nil {
+		return &GTKError{Op: "Window.SaveGeometry", Err: err}
+	}
+
+	geometries := loadWindowGeometries(path)
+	geometries[key] = geometry
+
+	data, err := json.MarshalIndent(geometries, "", "  ")
+	if err != nil {
+		return &GTKError{Op: "Window.SaveGeometry", Err: err}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return &GTKError{Op: "Window.SaveGeometry", Err: err}
+	}
+
+	// Write to a temporary file first so a crash never leaves a truncated file
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return &GTKError{Op: "Window.SaveGeometry", Err: err}
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return &GTKError{Op: "Window.SaveGeometry", Err: err}
+	}
+	return nil
+}
+
+// RestoreGeometry restores the size and maximized state saved under the
+// given key and saves them again when the window is closed, so it should be
+// called once, before the window is shown. If nothing usable was saved,
+// e.g. on first run or if the file is corrupt, the window keeps the size set
+// with SetDefaultSize. Returns whether a saved geometry was applied.
+func (w *Window) RestoreGeometry(key string) bool {
+	w.ConnectCloseRequest(func() bool {
+		if err := w.SaveGeometry(key); err != nil {
+			DebugLog(DebugLevelWarning, DebugComponentGeneral, "Can't save window geometry: %v", err)
+		}
+		// Let the window close
+		return false
+	})
+
+	windowGeometryMutex.Lock()
+	path, err := windowGeometryPath()
+	var geometry windowGeometry
+	var ok bool
+	if err == nil {
+		geometry, ok = loadWindowGeometries(path)[key]
+	}
+	windowGeometryMutex.Unlock()
+
+	if !ok || geometry.Width <= 0 || geometry.Height <= 0 {
+		return false
+	}
+
+	w.SetDefaultSize(geometry.Width, geometry.Height)
+	if geometry.Maximized {
+		w.SetMaximized(true)
+	}
+	return true
+}
@@ -78,17 +78,46 @@ package gtk4
 //     // Last resort: use default size
 //     gtk_window_get_default_size(window, width, height);
 // }
+//
+// extern void windowNotifySizeCallback(GtkWindow *window, gpointer user_data);
+// extern void windowNotifySizeDestroy(gpointer user_data, GClosure *closure);
+//
+// // Forward notifications of the properties that change with the window size
+// static void windowNotifySizeFilter(GObject *object, GParamSpec *pspec, gpointer user_data) {
+//     const char *name = g_param_spec_get_name(pspec);
+//     if (g_str_equal(name, "default-width") || g_str_equal(name, "default-height") ||
+//         g_str_equal(name, "maximized") || g_str_equal(name, "fullscreened")) {
+//         windowNotifySizeCallback(GTK_WINDOW(object), user_data);
+//     }
+// }
+//
+// // The destroy notify runs when the handler is disconnected, including when
+// // the window is finalized
+// static gulong connectWindowNotifySize(GtkWindow *window, guint callbackId) {
+//     return g_signal_connect_data(window, "notify", G_CALLBACK(windowNotifySizeFilter), GUINT_TO_POINTER(callbackId),
+//                                  (GClosureNotify)windowNotifySizeDestroy, 0);
+// }
 import "C"
 
 import (
+	"sync"
 	"sync/atomic"
 	"time"
 	"unsafe"
 
+	"github.com/justyntemme/gtk4go"
 	// Import the core uithread package for thread-safe operations
 	"github.com/justyntemme/gtk4go/core/uithread"
 )
 
+// SignalNotifySize is the signal type under which ConnectNotifySize
+// callbacks are registered
+const SignalNotifySize SignalType = "notify-size"
+
+// sizeNotifyDebounce is how long the window size must stay the same before
+// ConnectNotifySize callbacks are called
+const sizeNotifyDebounce = 100 * time.Millisecond
+
 // windowResizeState stores state information for resize detection
 type windowResizeState struct {
 	// Atomic fields for thread-safe access
@@ -291,14 +320,20 @@ func (w *Window) IsResizing() bool {
 	return state.isResizing.Load()
 }
 
-// GetSize returns the current window size
-func (w *Window) GetSize() (width, height int32) {
+// GetSize returns the current window size. Before the window is shown, the
+// default size is returned.
+func (w *Window) GetSize() (width, height int) {
 	windowPtr := uintptr(unsafe.Pointer(w.widget))
-	state, ok := windowResizeStates[windowPtr]
-	if !ok {
-		return 0, 0
+	if state, ok := windowResizeStates[windowPtr]; ok && state.width.Load() > 0 {
+		return int(state.width.Load()), int(state.height.Load())
 	}
-	return state.width.Load(), state.height.Load()
+
+	width = int(C.gtk_widget_get_width(w.widget))
+	height = int(C.gtk_widget_get_height(w.widget))
+	if width > 0 && height > 0 {
+		return width, height
+	}
+	return w.GetDefaultSize()
 }
 
 // CleanupResizeDetection cleans up resize detection for a window
@@ -329,3 +364,83 @@ func (w *Window) SetupCSSOptimizedResize() {
 	})
 }
 
+// sizeNotifier debounces the size notifications of a window for a
+// ConnectNotifySize callback. It is only used on the UI thread.
+type sizeNotifier struct {
+	window                *Window
+	callback              func(width, height int)
+	pending               gtk4go.SourceHandle
+	lastWidth, lastHeight int
+}
+
+// sizeNotifiers maps the IDs of ConnectNotifySize callbacks to their
+// notifiers, so that a pending timeout can be removed on disconnect
+var sizeNotifiers sync.Map
+
+// notify restarts the debounce period on every size notification
+func (n *sizeNotifier) notify() {
+	n.stop()
+	n.pending = gtk4go.TimeoutAdd(int(sizeNotifyDebounce.Milliseconds()), func() bool {
+		n.pending = 0
+		if n.window.widget == nil {
+			return false
+		}
+
+		width, height := n.window.GetSize()
+		if width == n.lastWidth && height == n.lastHeight {
+			return false
+		}
+		n.lastWidth, n.lastHeight = width, height
+		n.callback(width, height)
+		return false
+	})
+}
+
+// stop removes the pending timeout, if any
+func (n *sizeNotifier) stop() {
+	if n.pending != 0 {
+		gtk4go.SourceRemove(n.pending)
+		n.pending = 0
+	}
+}
+
+// ConnectNotifySize connects a callback for changes of the window size, e.g.
+// to switch between a one- and a two-column layout at a certain width. During
+// a continuous resize the callback is only called once the size has stayed
+// the same for 100ms, and only if it differs from the size it was last called
+// with, so it doesn't run on every frame. Maximizing and fullscreening the
+// window count as size changes. The returned ID can be used with Disconnect;
+// a pending call is dropped when the callback is disconnected or the window
+// is destroyed.
+func (w *Window) ConnectNotifySize(callback func(width, height int)) uint64 {
+	notifier := &sizeNotifier{window: w, callback: callback, lastWidth: -1, lastHeight: -1}
+
+	return connectCustomSignal(w, SignalNotifySize, notifier.notify, func(id C.guint) C.gulong {
+		sizeNotifiers.Store(uint64(id), notifier)
+		return C.connectWindowNotifySize((*C.GtkWindow)(unsafe.Pointer(w.widget)), id)
+	})
+}
+
+//export windowNotifySizeCallback
+func windowNotifySizeCallback(window *C.GtkWindow, userData C.gpointer) {
+	callback, ok := lookupCallback(userData)
+	if !ok {
+		return
+	}
+
+	// Restart the debounce timer during the notification, so a timer
+	// started earlier can't fire in between
+	if cb, ok := callback.(func()); ok {
+		uithread.Protect(cb)
+	} else {
+		DebugLog(DebugLevelError, DebugComponentCallback,
+			"windowNotifySizeCallback: callback has wrong type: %T", callback)
+	}
+}
+
+//export windowNotifySizeDestroy
+func windowNotifySizeDestroy(userData C.gpointer, closure *C.GClosure) {
+	if value, ok := sizeNotifiers.LoadAndDelete(uint64(uintptr(userData))); ok {
+		value.(*sizeNotifier).stop()
+	}
+}
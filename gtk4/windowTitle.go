@@ -0,0 +1,117 @@
+// Package gtk4 provides window title functionality for GTK4
+// File: gtk4go/gtk4/windowTitle.go
+package gtk4
+
+// #cgo pkg-config: gtk4
+// #include <gtk/gtk.h>
+// #include <stdlib.h>
+//
+// // Create a title and a subtitle label stacked vertically, styled like the
+// // default header bar title. The labels are owned by the box.
+// static GtkWidget* createWindowTitle() {
+//     GtkWidget *box = gtk_box_new(GTK_ORIENTATION_VERTICAL, 0);
+//     gtk_widget_set_valign(box, GTK_ALIGN_CENTER);
+//
+//     GtkWidget *title = gtk_label_new(NULL);
+//     gtk_label_set_single_line_mode(GTK_LABEL(title), TRUE);
+//     gtk_label_set_ellipsize(GTK_LABEL(title), PANGO_ELLIPSIZE_END);
+//     gtk_widget_add_css_class(title, "title");
+//     gtk_box_append(GTK_BOX(box), title);
+//
+//     GtkWidget *subtitle = gtk_label_new(NULL);
+//     gtk_label_set_single_line_mode(GTK_LABEL(subtitle), TRUE);
+//     gtk_label_set_ellipsize(GTK_LABEL(subtitle), PANGO_ELLIPSIZE_END);
+//     gtk_widget_add_css_class(subtitle, "subtitle");
+//     gtk_widget_set_visible(subtitle, FALSE);
+//     gtk_box_append(GTK_BOX(box), subtitle);
+//
+//     return box;
+// }
+//
+// static GtkLabel* windowTitleLabel(GtkWidget *box) {
+//     return GTK_LABEL(gtk_widget_get_first_child(box));
+// }
+//
+// static GtkLabel* windowSubtitleLabel(GtkWidget *box) {
+//     return GTK_LABEL(gtk_widget_get_last_child(box));
+// }
+//
+// // Set the subtitle, hiding it while empty so the title stays centered
+// static void windowTitleSetSubtitle(GtkWidget *box, const char *subtitle) {
+//     GtkLabel *label = windowSubtitleLabel(box);
+//     gtk_label_set_text(label, subtitle);
+//     gtk_widget_set_visible(GTK_WIDGET(label), subtitle[0] != '\0');
+// }
+import "C"
+
+import (
+	"unsafe"
+)
+
+// WindowTitleOption is a function that configures a window title
+type WindowTitleOption func(*WindowTitle)
+
+// WindowTitle represents a title with an optional subtitle, for use as the
+// title widget of a HeaderBar
+type WindowTitle struct {
+	BaseWidget
+}
+
+// NewWindowTitle creates a new window title
+func NewWindowTitle(title string, options ...WindowTitleOption) *WindowTitle {
+	windowTitle := &WindowTitle{
+		BaseWidget: BaseWidget{
+			widget: C.createWindowTitle(),
+		},
+	}
+
+	windowTitle.SetTitle(title)
+
+	// Apply options
+	for _, option := range options {
+		option(windowTitle)
+	}
+
+	SetupFinalization(windowTitle, windowTitle.Destroy)
+	return windowTitle
+}
+
+// WithSubtitle sets the subtitle shown below the title
+func WithSubtitle(subtitle string) WindowTitleOption {
+	return func(wt *WindowTitle) {
+		wt.SetSubtitle(subtitle)
+	}
+}
+
+// SetTitle sets the title
+func (wt *WindowTitle) SetTitle(title string) {
+	cTitle := C.CString(title)
+	defer C.free(unsafe.Pointer(cTitle))
+	C.gtk_label_set_text(C.windowTitleLabel(wt.widget), cTitle)
+}
+
+// GetTitle returns the title
+func (wt *WindowTitle) GetTitle() string {
+	return C.GoString(C.gtk_label_get_text(C.windowTitleLabel(wt.widget)))
+}
+
+// SetSubtitle sets the subtitle shown below the title; an empty string hides it
+func (wt *WindowTitle) SetSubtitle(subtitle string) {
+	cSubtitle := C.CString(subtitle)
+	defer C.free(unsafe.Pointer(cSubtitle))
+	C.windowTitleSetSubtitle(wt.widget, cSubtitle)
+}
+
+// GetSubtitle returns the subtitle
+func (wt *WindowTitle) GetSubtitle() string {
+	return C.GoString(C.gtk_label_get_text(C.windowSubtitleLabel(wt.widget)))
+}
+
+// Destroy destroys the window title and cleans up resources
+func (wt *WindowTitle) Destroy() {
+	// Disconnect all signals for this widget
+	DisconnectAll(wt)
+
+	// Call base destroy method
+	wt.BaseWidget.Destroy()
+}
@@ -5,14 +5,43 @@ package gtk4go
 // #cgo pkg-config: gtk4
 // #include <gtk/gtk.h>
 // #include <stdlib.h>
+// #include <pthread.h>
+//
+// // The thread GTK is initialized on, which runs the main loop
+// static pthread_t uiThread;
+//
+// static void recordUIThread(void) {
+//     uiThread = pthread_self();
+// }
+//
+// // Also accept a thread that has acquired the main context, in case the
+// // main loop is run from another thread
+// static gboolean isUIThread(void) {
+//     return pthread_equal(pthread_self(), uiThread) || g_main_context_is_owner(g_main_context_default());
+// }
 //
 // // C callback for idle functions
 // extern gboolean idleCallback(gpointer user_data);
+// extern gboolean timeoutCallback(gpointer user_data);
 //
-// // Add an idle function to be called on the main loop
-// static guint addIdleFunction(gpointer user_data) {
+// // Create an idle source for a function to be called on the main loop.
+// // The caller owns a reference and attaches the source when ready.
+// static GSource* newIdleSource(gpointer user_data) {
+//     GSource *source = g_idle_source_new();
 //     // Use GSourceFunc signature (gboolean (*)(gpointer)) explicitly
-//     return g_idle_add((GSourceFunc)idleCallback, user_data);
+//     g_source_set_callback(source, (GSourceFunc)idleCallback, user_data, NULL);
+//     return source;
+// }
+//
+// // Add a timeout function to be called periodically on the main loop
+// static guint addTimeoutFunction(guint interval, gpointer user_data) {
+//     return g_timeout_add(interval, (GSourceFunc)timeoutCallback, user_data);
+// }
+//
+// // Add a timeout with a granularity of seconds, which GLib may group with
+// // other timeouts to wake the process less often
+// static guint addTimeoutSecondsFunction(guint interval, gpointer user_data) {
+//     return g_timeout_add_seconds(interval, (GSourceFunc)timeoutCallback, user_data);
 // }
 //
 // // Remove a source from the main loop
@@ -22,9 +51,12 @@ package gtk4go
 import "C"
 
 import (
+	"errors"
 	"fmt"
+	"runtime"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	// Import the core uithread package
 	"github.com/justyntemme/gtk4go/core/uithread"
@@ -34,10 +66,33 @@ import (
 var (
 	initialized bool
 	initMutex   sync.Mutex
-	idleHandles sync.Map // Maps uint64 keys to idle handles
+	idleHandles sync.Map // Maps UIThreadHandle keys to *idleCall
 	nextIdleKey atomic.Uint64
+
+	timeoutHandles sync.Map // Maps uint64 keys to *timeoutSource
+	nextTimeoutKey atomic.Uint64
 )
 
+// UIThreadHandle identifies a function scheduled to run on the UI thread,
+// so that it can be cancelled with CancelUIThreadCall. The zero handle
+// refers to a function that has already run.
+type UIThreadHandle uint64
+
+// idleCall tracks a function scheduled to run on the UI thread. Calls made
+// with RunOnUIThread wait in the uithread dispatch queue and have no source
+// or cancel function of their own.
+type idleCall struct {
+	fn     func()     // Runs the function
+	cancel func()     // Stops counting the function in uithread.QueueDepth, or nil
+	source *C.GSource // Owned reference, released when the call runs or is cancelled, or nil
+}
+
+// timeoutSource tracks a function scheduled with g_timeout_add
+type timeoutSource struct {
+	fn       func() bool
+	sourceID atomic.Uint32
+}
+
 // Initialize ensures GTK is initialized and starts the dispatch queue.
 // This is automatically called when importing the package.
 func Initialize() error {
@@ -48,6 +103,10 @@ func Initialize() error {
 		return nil
 	}
 
+	// GTK must only be used from the thread it is initialized on
+	C.recordUIThread()
+	uithread.RegisterUIThreadCheck = IsUIThread
+
 	// Check if GTK is already initialized
 	if C.gtk_is_initialized() == C.FALSE {
 		// Initialize GTK
@@ -61,14 +120,7 @@ func Initialize() error {
 	// precedence but provides a fallback using GTK's idle mechanism.
 	if uithread.RegisterIdleHandler == nil {
 		uithread.RegisterIdleHandler = func(fn func()) {
-			// Get a unique key for this function
-			key := nextIdleKey.Add(1)
-
-			// Store the function in the idle handles map
-			idleHandles.Store(key, fn)
-
-			// Schedule the function to be executed on the UI thread via GTK
-			C.addIdleFunction(C.gpointer(uintptr(key)))
+			scheduleIdle(fn)
 		}
 	}
 
@@ -77,37 +129,262 @@ func Initialize() error {
 }
 
 // RunOnUIThread schedules a function to be executed on the UI thread.
-func RunOnUIThread(fn func()) {
-	uithread.RunOnUIThread(fn)
+// If called from the UI thread, the function is executed immediately and
+// the zero handle is returned. The returned handle can be passed to
+// CancelUIThreadCall to cancel the function before it runs.
+// Functions run in the order they were scheduled, also relative to
+// RunOnUIThreadSync, gtk4.RunOnUIThread and uithread.RunOnUIThread, as they
+// all go through the same queue.
+func RunOnUIThread(fn func()) UIThreadHandle {
+	if IsUIThread() {
+		fn()
+		return 0
+	}
+
+	key := storeIdleCall(&idleCall{fn: fn})
+	uithread.RunOnUIThread(func() {
+		// The call is missing if it was cancelled while queued
+		if value, ok := idleHandles.LoadAndDelete(key); ok {
+			value.(*idleCall).fn()
+		}
+	})
+	return key
+}
+
+// CancelUIThreadCall cancels a function scheduled with RunOnUIThread.
+// Returns false if the function has already run or was already cancelled,
+// in which case this is a no-op.
+func CancelUIThreadCall(handle UIThreadHandle) bool {
+	value, ok := idleHandles.LoadAndDelete(handle)
+	if !ok {
+		return false
+	}
+
+	call := value.(*idleCall)
+	if call.cancel != nil {
+		call.cancel()
+	}
+	if call.source != nil {
+		// Destroying a source is safe even if the main loop has just removed it
+		C.g_source_destroy(call.source)
+		C.g_source_unref(call.source)
+	}
+	return true
+}
+
+// CancelAllUIThreadCalls cancels all functions that are scheduled to run on
+// the UI thread but haven't run yet, releasing them. It is called when a
+// gtk4.Application stops running, as they can't run once the main loop has
+// stopped.
+func CancelAllUIThreadCalls() {
+	idleHandles.Range(func(key, _ any) bool {
+		CancelUIThreadCall(key.(UIThreadHandle))
+		return true
+	})
+}
+
+// scheduleIdle schedules a function to be executed on the UI thread via a
+// GLib idle source, which may be attached from any thread. A panic in the
+// function is recovered and reported, see uithread.SetPanicHandler.
+func scheduleIdle(fn func()) UIThreadHandle {
+	run, cancel := uithread.Track(fn)
+	call := &idleCall{fn: run, cancel: cancel}
+	key := storeIdleCall(call)
+
+	// The source only becomes eligible to run once attached, so the
+	// callback always finds call.source set
+	call.source = C.newIdleSource(C.gpointer(uintptr(key)))
+	C.g_source_attach(call.source, nil)
+	return key
+}
+
+// storeIdleCall stores a call in idleHandles under a new key and returns
+// the key. After the counter wraps around, the zero handle and keys of calls
+// that are still pending are skipped.
+func storeIdleCall(call *idleCall) UIThreadHandle {
+	for {
+		key := UIThreadHandle(nextIdleKey.Add(1))
+		if key == 0 {
+			continue
+		}
+		if _, loaded := idleHandles.LoadOrStore(key, call); !loaded {
+			return key
+		}
+	}
+}
+
+// ErrUIThreadTimeout is returned by RunOnUIThreadTimeout when the UI thread
+// didn't run the function in time
+var ErrUIThreadTimeout = errors.New("timed out waiting for the UI thread")
+
+// RunOnUIThreadSync executes a function on the UI thread and waits until it
+// has returned. If called from the UI thread, e.g. from a signal callback,
+// the function runs immediately, as waiting for the main loop would deadlock.
+func RunOnUIThreadSync(fn func()) {
+	if IsUIThread() {
+		fn()
+		return
+	}
+
+	done := make(chan struct{})
+	uithread.RunOnUIThread(func() {
+		defer close(done)
+		fn()
+	})
+	<-done
+}
+
+// RunOnUIThreadSyncResult executes a function on the UI thread and returns
+// its result, e.g. to read a widget value from a background goroutine.
+// If called from the UI thread, the function runs immediately.
+func RunOnUIThreadSyncResult[T any](fn func() T) T {
+	var result T
+	RunOnUIThreadSync(func() {
+		result = fn()
+	})
+	return result
 }
 
-// IsUIThread returns true if the current goroutine is running on the UI thread
+// RunOnUIThreadTimeout executes a function on the UI thread and waits at most
+// timeout for it to return, which avoids hanging when the main loop is no
+// longer running, e.g. during shutdown. On timeout it returns
+// ErrUIThreadTimeout; the function may still run later.
+// If called from the UI thread, the function runs immediately.
+func RunOnUIThreadTimeout(fn func(), timeout time.Duration) error {
+	if IsUIThread() {
+		fn()
+		return nil
+	}
+
+	done := make(chan struct{})
+
+	// Queue from a separate goroutine, as queuing itself blocks while the
+	// dispatch queue is full
+	go uithread.RunOnUIThread(func() {
+		defer close(done)
+		fn()
+	})
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(timeout):
+		return ErrUIThreadTimeout
+	}
+}
+
+// IsUIThread returns true if the current goroutine is running on the UI
+// thread: the thread GTK was initialized on, or a thread running the main loop
 func IsUIThread() bool {
-	return uithread.IsUIThread()
+	return C.isUIThread() == C.TRUE
+}
+
+// iterateMainLoop runs a single iteration of the main loop, waiting for an
+// event if block is true. It lets tests, which can't use cgo, run the main
+// loop themselves.
+func iterateMainLoop(block bool) bool {
+	var cBlock C.gboolean
+	if block {
+		cBlock = C.TRUE
+	} else {
+		cBlock = C.FALSE
+	}
+	return C.g_main_context_iteration(nil, cBlock) == C.TRUE
 }
 
 //export idleCallback
 func idleCallback(userData C.gpointer) C.gboolean {
 	// Get the key from the user data
-	key := uint64(uintptr(userData))
+	key := UIThreadHandle(uintptr(userData))
 
-	// Get the function from the idle handles map
-	fnVal, ok := idleHandles.Load(key)
+	// Take the call from the idle handles map; it is missing if cancelled
+	value, ok := idleHandles.LoadAndDelete(key)
 	if !ok {
 		return C.FALSE
 	}
 
-	// Remove the function from the map
-	idleHandles.Delete(key)
-
 	// Call the function
-	fn := fnVal.(func())
-	fn()
+	call := value.(*idleCall)
+	defer C.g_source_unref(call.source)
+	call.fn()
 
 	// Return FALSE to remove the idle function
 	return C.FALSE
 }
 
+// addTimeout schedules fn to run on the UI thread every interval until it
+// returns false or the timeout is removed. It returns a key for removeTimeout.
+func addTimeout(interval time.Duration, fn func() bool) uint64 {
+	return addTimeoutSource(interval, false, fn)
+}
+
+// addTimeoutSource is addTimeout with the option to schedule the timeout
+// with a granularity of whole seconds
+func addTimeoutSource(interval time.Duration, seconds bool, fn func() bool) uint64 {
+	key := nextTimeoutKey.Add(1)
+
+	// Store the source before scheduling so the callback can always find it
+	source := &timeoutSource{fn: fn}
+	timeoutHandles.Store(key, source)
+
+	var sourceID C.guint
+	if seconds {
+		sourceID = C.addTimeoutSecondsFunction(C.guint(interval/time.Second), C.gpointer(uintptr(key)))
+	} else {
+		ms := interval.Milliseconds()
+		if ms < 0 {
+			ms = 0
+		}
+		sourceID = C.addTimeoutFunction(C.guint(ms), C.gpointer(uintptr(key)))
+	}
+	source.sourceID.Store(uint32(sourceID))
+
+	return key
+}
+
+// removeTimeout removes a timeout scheduled with addTimeout. It returns
+// false if the timeout already finished or was removed, which is a no-op.
+func removeTimeout(key uint64) bool {
+	value, ok := timeoutHandles.LoadAndDelete(key)
+	if !ok {
+		return false
+	}
+
+	if sourceID := value.(*timeoutSource).sourceID.Load(); sourceID != 0 {
+		C.removeSource(C.guint(sourceID))
+	}
+	return true
+}
+
+//export timeoutCallback
+func timeoutCallback(userData C.gpointer) C.gboolean {
+	// Get the key from the user data
+	key := uint64(uintptr(userData))
+
+	// Get the source from the timeout handles map
+	value, ok := timeoutHandles.Load(key)
+	if !ok {
+		return C.FALSE
+	}
+
+	// Call the function and keep the source only if it asks to continue.
+	// A panic is reported like in other UI thread functions and stops it.
+	keep := false
+	uithread.Protect(func() {
+		keep = value.(*timeoutSource).fn()
+	})
+	if keep {
+		// The function may have removed its own timeout
+		if _, stillActive := timeoutHandles.Load(key); stillActive {
+			return C.TRUE
+		}
+		return C.FALSE
+	}
+
+	timeoutHandles.Delete(key)
+	return C.FALSE
+}
+
 // Events returns the global GTK events channel.
 func Events() chan any {
 	return events
@@ -117,6 +394,11 @@ var events = make(chan any, 10)
 
 // init initializes the GTK4 library.
 func init() {
+	// Package initialization runs on the main thread. Keep the main
+	// goroutine there, since GTK is initialized on it and must only be
+	// used from it.
+	runtime.LockOSThread()
+
 	// Initialize GTK
 	Initialize()
 }
@@ -0,0 +1,111 @@
+package gtk4go
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/justyntemme/gtk4go/core/uithread"
+)
+
+// TestMain runs the main loop on the main thread, where GTK was
+// initialized, while the tests run on other goroutines
+func TestMain(m *testing.M) {
+	var (
+		code int
+		done bool
+	)
+	go func() {
+		result := m.Run()
+		// Set on the UI thread; the idle source also wakes up the main loop
+		scheduleIdle(func() {
+			code, done = result, true
+		})
+	}()
+
+	for !done {
+		iterateMainLoop(true)
+	}
+	os.Exit(code)
+}
+
+func TestIsUIThread(t *testing.T) {
+	if IsUIThread() {
+		t.Error("IsUIThread is true on a test goroutine")
+	}
+
+	onUIThread := false
+	if err := RunOnUIThreadTimeout(func() {
+		onUIThread = IsUIThread()
+	}, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if !onUIThread {
+		t.Error("IsUIThread is false in a function run on the UI thread")
+	}
+}
+
+func TestRunOnUIThreadSyncReentrant(t *testing.T) {
+	// A sync call from the UI thread, as made from a signal callback, must
+	// run inline rather than wait for the blocked main loop
+	ran := false
+	err := RunOnUIThreadTimeout(func() {
+		RunOnUIThreadSync(func() {
+			ran = true
+		})
+		if !ran {
+			t.Error("nested RunOnUIThreadSync didn't run the function before returning")
+		}
+	}, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunOnUIThreadSyncResult(t *testing.T) {
+	got := RunOnUIThreadSyncResult(func() bool {
+		return RunOnUIThreadSyncResult(IsUIThread)
+	})
+	if !got {
+		t.Error("nested RunOnUIThreadSyncResult didn't run on the UI thread")
+	}
+}
+
+func TestRunOnUIThreadOrder(t *testing.T) {
+	// Functions scheduled through any of the APIs run in the order they
+	// were scheduled
+	var order []int
+	RunOnUIThread(func() { order = append(order, 1) })
+	uithread.RunOnUIThread(func() { order = append(order, 2) })
+	RunOnUIThread(func() { order = append(order, 3) })
+	RunOnUIThreadSync(func() { order = append(order, 4) })
+
+	if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(order, want) {
+		t.Errorf("functions ran in order %v, want %v", order, want)
+	}
+}
+
+func TestCancelUIThreadCall(t *testing.T) {
+	// Keep the UI thread busy so the second function can't run yet
+	release := make(chan struct{})
+	RunOnUIThread(func() { <-release })
+
+	ran := false
+	handle := RunOnUIThread(func() { ran = true })
+	if handle == 0 {
+		t.Fatal("RunOnUIThread from a test goroutine returned the zero handle")
+	}
+	if !CancelUIThreadCall(handle) {
+		t.Error("CancelUIThreadCall of a pending function returned false")
+	}
+	if CancelUIThreadCall(handle) {
+		t.Error("second CancelUIThreadCall returned true")
+	}
+	close(release)
+
+	RunOnUIThreadSync(func() {})
+	if ran {
+		t.Error("cancelled function ran")
+	}
+}
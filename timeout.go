@@ -0,0 +1,49 @@
+// Package gtk4go provides timeout scheduling on the UI thread
+// File: gtk4go/timeout.go
+package gtk4go
+
+import (
+	"time"
+)
+
+// SourceHandle identifies a function scheduled with TimeoutAdd or
+// IntervalAdd, so that it can be removed with SourceRemove
+type SourceHandle uint64
+
+// TimeoutAdd calls fn on the UI thread after ms milliseconds, and again every
+// ms milliseconds for as long as it returns true. Unlike time.AfterFunc, fn
+// runs on the GTK main loop, so it can use widgets directly and never
+// overlaps with itself; a slow fn delays the next call instead. A panic in
+// fn is reported (see uithread.SetPanicHandler) and stops the timeout.
+func TimeoutAdd(ms int, fn func() bool) SourceHandle {
+	return SourceHandle(addTimeout(time.Duration(ms)*time.Millisecond, fn))
+}
+
+// IntervalAdd calls fn on the UI thread every interval for as long as it
+// returns true, like TimeoutAdd. Intervals of whole seconds are scheduled
+// with a granularity of one second, which lets GLib wake the process for
+// several timers at once; use it for periodic refreshes that don't need to
+// be exact.
+func IntervalAdd(interval time.Duration, fn func() bool) SourceHandle {
+	if interval >= time.Second && interval%time.Second == 0 {
+		return SourceHandle(addTimeoutSource(interval, true, fn))
+	}
+	return SourceHandle(addTimeout(interval, fn))
+}
+
+// SourceRemove removes a function scheduled with TimeoutAdd or IntervalAdd,
+// which may also be done from within the function itself. It returns false
+// if the function had already stopped or was removed before.
+func SourceRemove(handle SourceHandle) bool {
+	return removeTimeout(uint64(handle))
+}
+
+// RemoveAllSources removes all functions scheduled with TimeoutAdd or
+// IntervalAdd, releasing them. It is called when a gtk4.Application stops
+// running, so that timeouts don't outlive the application.
+func RemoveAllSources() {
+	timeoutHandles.Range(func(key, _ any) bool {
+		removeTimeout(key.(uint64))
+		return true
+	})
+}